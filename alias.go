@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+)
+
+func (this *validator) RegisterAlias(name string, expression string) error {
+	alias, err := parser.ParseAlias(name, expression)
+
+	if err != nil {
+		this.locales.Translate(err, this.language)
+		return err
+	}
+
+	this.aliasLock.Lock()
+	defer this.aliasLock.Unlock()
+
+	aliases := parser.Aliases{}
+
+	for aliasName, existing := range this.aliases {
+		aliases[aliasName] = existing
+	}
+
+	aliases[alias.Name] = alias
+
+	// Expanding the alias reveals cycles that it closes, and calls of other aliases with wrong arguments.
+	if _, err := aliases.Expand(&parser.Method{Name: alias.Name, Arguments: make(parser.Arguments, len(alias.Params))}); err != nil {
+		this.locales.Translate(err, this.language)
+		return err
+	}
+
+	this.aliases = aliases
+	this.aliasGeneration++
+	this.expandedFields = map[*core.ReflectedField]*core.ReflectedField{}
+
+	this.lock.Lock()
+	this.checkedStructs = map[reflect.Type]core.ConfigErrors{}
+	this.lock.Unlock()
+
+	return nil
+}
+
+// getStructFields returns the fields of a struct type with the aliases in their expressions expanded. The expanded
+// copies of the fields are cached until another alias is registered.
+func (this *validator) getStructFields(structType reflect.Type) ([]*core.ReflectedField, error) {
+	fields, err := core.GetStructFieldsWithMessages(reflect.Zero(structType).Interface(), "validate", this.displayNameTag, this.messageTag)
+
+	if err != nil {
+		return nil, err
+	}
+
+	this.aliasLock.RLock()
+	aliases, generation := this.aliases, this.aliasGeneration
+	this.aliasLock.RUnlock()
+
+	if len(aliases) == 0 {
+		return fields, nil
+	}
+
+	expandedFields := make([]*core.ReflectedField, len(fields))
+	var newFields map[*core.ReflectedField]*core.ReflectedField
+
+	for i, field := range fields {
+		this.aliasLock.RLock()
+		expandedField, ok := this.expandedFields[field]
+		this.aliasLock.RUnlock()
+
+		if !ok {
+			expression, err := aliases.Expand(field.Expression)
+
+			if err != nil {
+				return nil, &core.ConfigError{Type: structType, Field: field.Name, Err: err}
+			}
+
+			copiedField := *field
+			copiedField.Expression = expression
+			copiedField.MethodGroups = parser.Groups(expression)
+			expandedField = &copiedField
+
+			if newFields == nil {
+				newFields = map[*core.ReflectedField]*core.ReflectedField{}
+			}
+			newFields[field] = expandedField
+		}
+
+		expandedFields[i] = expandedField
+	}
+
+	if len(newFields) > 0 {
+		this.aliasLock.Lock()
+		// Fields that were expanded with aliases that have been replaced meanwhile aren't cached.
+		if generation == this.aliasGeneration {
+			for field, expandedField := range newFields {
+				this.expandedFields[field] = expandedField
+			}
+		}
+		this.aliasLock.Unlock()
+	}
+
+	return expandedFields, nil
+}
@@ -0,0 +1,66 @@
+// Command localecheck compares the locales in a directory to the locale of the default language. It reports keys
+// that are missing or extra, messages whose placeholders differ from the default message, and keys of the built-in
+// validators that can't be resolved.
+//
+// Usage:
+//
+//	localecheck [-default en] [-builtin=true] [-extra=true] DIR
+//
+// The directory contains a locale file per language, i.e. "en.json" and "de.po", or a directory per language.
+// Exits with status 1 if any issues are found and with status 2 if the locales can't be loaded.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/validators"
+	"os"
+)
+
+func main() {
+	defaultTag := flag.String("default", "en", "the language that the other languages are compared to")
+	builtin := flag.Bool("builtin", true, "load the built-in locales before the locales of the directory")
+	extra := flag.Bool("extra", true, "report keys that the default language doesn't have")
+
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: localecheck [flags] DIR")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	bundle := core.NewLocaleBundle(*defaultTag)
+
+	if *builtin {
+		validators.RegisterDefaultLocales(bundle)
+	}
+
+	if err := bundle.LoadFS(os.DirFS(flag.Arg(0)), "."); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	signatures := core.NewSignatureRegistry()
+	validators.RegisterDefaultSignatures(signatures)
+
+	issues := append(bundle.CheckCoverage(), core.CheckLocaleKeys(bundle, signatures)...)
+	found := 0
+
+	for _, issue := range issues {
+		if issue.Kind == core.ExtraKey && !*extra {
+			continue
+		}
+		fmt.Println(issue)
+		found++
+	}
+
+	if found > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,328 @@
+// Command validatorfmt rewrites the validate tags of struct fields in Go source files to the canonical tag syntax,
+// which normalizes the spacing, quoting and formatting of arguments. Other tags and the rest of the source are left as
+// they are.
+//
+// Usage:
+//
+//	validatorfmt [-l] [-d] [-w] [-tag validate] [path ...]
+//
+// Directories are walked for .go files. Without a path, standard input is formatted to standard output. By default the
+// formatted source is written to standard output. Exits with status 2 if a file can't be read or parsed, or if a tag
+// has invalid syntax.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/typerandom/validator/core/parser"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	list    = flag.Bool("l", false, "list files whose formatting differs from validatorfmt's")
+	diff    = flag.Bool("d", false, "display diffs instead of rewriting files")
+	write   = flag.Bool("w", false, "write result to (source) file instead of stdout")
+	tagName = flag.String("tag", "validate", "the key of the tags to format")
+)
+
+var exitCode = 0
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: validatorfmt [flags] [path ...]")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		if *write {
+			fmt.Fprintln(os.Stderr, "error: cannot use -w with standard input")
+			os.Exit(2)
+		}
+
+		src, err := io.ReadAll(os.Stdin)
+
+		if err == nil {
+			err = processFile("<standard input>", src, os.Stdout)
+		}
+
+		report(err)
+		os.Exit(exitCode)
+	}
+
+	for _, root := range flag.Args() {
+		info, err := os.Stat(root)
+
+		if err != nil {
+			report(err)
+			continue
+		}
+
+		if !info.IsDir() {
+			report(processPath(root))
+			continue
+		}
+
+		report(filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			name := entry.Name()
+
+			if entry.IsDir() {
+				if path != root && strings.HasPrefix(name, ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if strings.HasSuffix(name, ".go") && !strings.HasPrefix(name, ".") {
+				report(processPath(path))
+			}
+
+			return nil
+		}))
+	}
+
+	os.Exit(exitCode)
+}
+
+func report(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		exitCode = 2
+	}
+}
+
+func processPath(path string) error {
+	src, err := os.ReadFile(path)
+
+	if err != nil {
+		return err
+	}
+
+	return processFile(path, src, os.Stdout)
+}
+
+func processFile(filename string, src []byte, out io.Writer) error {
+	formatted, err := formatSource(filename, src)
+
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(src, formatted) {
+		if !*list && !*write && !*diff {
+			_, err = out.Write(formatted)
+		}
+		return err
+	}
+
+	if *list {
+		fmt.Fprintln(out, filename)
+	}
+
+	if *write {
+		info, err := os.Stat(filename)
+
+		if err != nil {
+			return err
+		}
+
+		if err = os.WriteFile(filename, formatted, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+
+	if *diff {
+		data, err := diffSource(filename, src, formatted)
+
+		if err != nil {
+			return fmt.Errorf("computing diff: %s", err)
+		}
+
+		fmt.Fprintf(out, "diff -u %s.orig %s\n", filename, filename)
+		out.Write(data)
+	}
+
+	if !*list && !*write && !*diff {
+		_, err = out.Write(formatted)
+	}
+
+	return err
+}
+
+// formatSource rewrites the tags of all struct fields in a source file. Tag literals are replaced in place, so the
+// rest of the source keeps its formatting.
+func formatSource(filename string, src []byte) ([]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := goparser.ParseFile(fileSet, filename, src, goparser.SkipObjectResolution)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result bytes.Buffer
+	var formatErr error
+	last := 0
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		field, ok := node.(*ast.Field)
+
+		if formatErr != nil || !ok || field.Tag == nil {
+			return formatErr == nil
+		}
+
+		literal, err := formatTagLiteral(field.Tag.Value)
+
+		if err != nil {
+			formatErr = fmt.Errorf("%s: %s", fileSet.Position(field.Tag.Pos()), err)
+			return false
+		}
+
+		if literal != field.Tag.Value {
+			start := fileSet.Position(field.Tag.Pos()).Offset
+			result.Write(src[last:start])
+			result.WriteString(literal)
+			last = start + len(field.Tag.Value)
+		}
+
+		return true
+	})
+
+	if formatErr != nil {
+		return nil, formatErr
+	}
+
+	result.Write(src[last:])
+
+	return result.Bytes(), nil
+}
+
+// formatTagLiteral formats the value of the tag key in a struct tag literal, i.e. `json:"name" validate:"min( 5 )"`.
+// The literal keeps its kind of quotes if possible.
+func formatTagLiteral(literal string) (string, error) {
+	tag, err := strconv.Unquote(literal)
+
+	if err != nil {
+		return "", err
+	}
+
+	start, end, ok := lookupTag(tag, *tagName)
+
+	if !ok {
+		return literal, nil
+	}
+
+	value, err := strconv.Unquote(tag[start:end])
+
+	if err != nil {
+		return "", err
+	}
+
+	formatted, err := parser.Format(value)
+
+	if err != nil {
+		if parseErr, ok := err.(*parser.ParseError); ok && parseErr.Snippet() != "" {
+			return "", fmt.Errorf("%s\n%s", err, parseErr.Snippet())
+		}
+		return "", err
+	}
+
+	if formatted == value {
+		return literal, nil
+	}
+
+	tag = tag[:start] + strconv.Quote(formatted) + tag[end:]
+
+	if strings.HasPrefix(literal, "`") && !strings.Contains(tag, "`") {
+		return "`" + tag + "`", nil
+	}
+
+	return strconv.Quote(tag), nil
+}
+
+// lookupTag finds the quoted value of a key in a struct tag, by the conventions of reflect.StructTag.Lookup. It
+// returns the offsets of the value including its quotes.
+func lookupTag(tag string, key string) (int, int, bool) {
+	i := 0
+
+	for i < len(tag) {
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+
+		nameStart := i
+
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+
+		if i == nameStart || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+
+		name := tag[nameStart:i]
+		valueStart := i + 1
+
+		for i = valueStart + 1; i < len(tag) && tag[i] != '"'; i++ {
+			if tag[i] == '\\' {
+				i++
+			}
+		}
+
+		if i >= len(tag) {
+			break
+		}
+
+		i++
+
+		if name == key {
+			return valueStart, i, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+func diffSource(filename string, original []byte, formatted []byte) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "validatorfmt")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir)
+
+	originalPath := filepath.Join(dir, "orig")
+	formattedPath := filepath.Join(dir, "formatted")
+
+	if err = os.WriteFile(originalPath, original, 0600); err != nil {
+		return nil, err
+	}
+
+	if err = os.WriteFile(formattedPath, formatted, 0600); err != nil {
+		return nil, err
+	}
+
+	data, err := exec.Command("diff", "-u", "--label", filename+".orig", "--label", filename, originalPath, formattedPath).Output()
+
+	// diff exits with status 1 if the files differ.
+	if len(data) > 0 {
+		return data, nil
+	}
+
+	return nil, err
+}
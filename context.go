@@ -2,13 +2,14 @@ package validator
 
 import (
 	"errors"
-	"fmt"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	"reflect"
 )
 
 type context struct {
 	validator *validator
+	options   *validateOptions
 
 	value        interface{}
 	originalKind reflect.Kind
@@ -17,6 +18,12 @@ type context struct {
 
 	errors core.ErrorList
 	source interface{}
+
+	// root is the value that was passed to Validate, which "$root" references start at.
+	root interface{}
+
+	// arguments are the arguments of the method that the current validator is called for.
+	arguments *core.Arguments
 }
 
 func (this *context) Source() interface{} {
@@ -45,6 +52,13 @@ func (this *context) OriginalKind() reflect.Kind {
 	return this.originalKind
 }
 
+func (this *context) Arguments() *core.Arguments {
+	if this.arguments == nil {
+		return core.NewArguments(nil, nil)
+	}
+	return this.arguments
+}
+
 func (this *context) Field() *core.ReflectedField {
 	return this.field
 }
@@ -54,17 +68,163 @@ func (this *context) IsNil() bool {
 }
 
 func (this *context) NewError(localeKey string, args ...interface{}) error {
-	message, err := this.validator.locale.Get(localeKey)
+	text, language, err := this.validator.locales.Resolve(this.options.language, localeKey)
 
 	if err != nil {
 		return err
 	}
 
-	if len(args) > 0 {
-		message = fmt.Sprintf(message, args...)
+	message := core.NewMessage(localeKey, text, this.value, args...)
+	message.Language = language
+
+	return message
+}
+
+func (this *context) NewConfigError(localeKey string, args ...interface{}) error {
+	err := this.NewError(localeKey, args...)
+
+	if core.IsConfigError(err) {
+		return err
 	}
 
-	return errors.New(message)
+	return core.NewConfigError(err)
+}
+
+// resolveArguments replaces the references in the arguments of a method by the values that they refer to.
+func (this *context) resolveArguments(args parser.Arguments) ([]interface{}, error) {
+	resolved := make([]interface{}, len(args))
+
+	for i, arg := range args {
+		switch typedArg := arg.(type) {
+		case *parser.Reference:
+			value, err := this.resolveReference(typedArg)
+
+			if err != nil {
+				return nil, err
+			}
+
+			resolved[i] = value
+		case parser.List:
+			values, err := this.resolveArguments(parser.Arguments(typedArg))
+
+			if err != nil {
+				return nil, err
+			}
+
+			resolved[i] = parser.List(values)
+		default:
+			resolved[i] = arg
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolveKeywords replaces the references in the keyword arguments of a method by the values that they refer to.
+func (this *context) resolveKeywords(keywords parser.Keywords) (parser.Keywords, error) {
+	values, err := this.resolveArguments(keywords.Values())
+
+	if err != nil || keywords == nil {
+		return nil, err
+	}
+
+	resolved := make(parser.Keywords, len(keywords))
+
+	for i, keyword := range keywords {
+		resolved[i] = &parser.Keyword{Name: keyword.Name, Value: values[i]}
+	}
+
+	return resolved, nil
+}
+
+func (this *context) resolveReference(reference *parser.Reference) (interface{}, error) {
+	var scope interface{}
+
+	switch reference.Scope {
+	case parser.ReferenceRoot:
+		scope = this.root
+	case parser.ReferenceContext:
+		scope = this.options.values
+	default:
+		scope = this.source
+	}
+
+	value, ok := core.ResolvePath(scope, reference.Path)
+
+	if !ok {
+		err := core.NewMessage("reference.cannotResolve", "Reference '{reference}' can't be resolved.", nil, core.Params{"reference": reference.String()})
+		this.validator.locales.Translate(err, this.options.language)
+		return nil, core.NewConfigError(err)
+	}
+
+	return core.ArgumentValue(value), nil
+}
+
+// negate inverts the result of a negated method or expression. Configuration mistakes and panics are never inverted.
+func (this *context) negate(expression parser.Expression, err error) error {
+	var panicErr *core.PanicError
+
+	if err != nil {
+		if core.IsConfigError(err) || errors.As(err, &panicErr) {
+			return err
+		}
+		return nil
+	}
+
+	var args []interface{}
+
+	if method, ok := expression.(*parser.Method); ok {
+		args = append(args, method.Arguments...)
+		args = append(args, core.Params{"expression": method.Call()})
+
+		// Validators can declare a dedicated message for their negation, i.e. "contain.negated".
+		key := method.Name + ".negated"
+
+		if text, language, ok := this.validator.locales.Lookup(this.options.language, key); ok {
+			message := core.NewMessage(key, text, this.value, args...)
+			message.Language = language
+			return message
+		}
+	} else {
+		args = append(args, core.Params{"expression": expression.Call()})
+	}
+
+	message := core.NewMessage("negation.mustNotSatisfy", "{field} must not satisfy {expression}.", this.value, args...)
+	this.validator.locales.Translate(message, this.options.language)
+
+	return message
+}
+
+// applyCustomMessage replaces the message of a failed method by the custom message that the field declares for it,
+// if there is one. Parameters of the original message remain available to the custom message.
+func (this *context) applyCustomMessage(groupIndex int, method string, err error) error {
+	text, ok := this.field.CustomMessage(groupIndex, method)
+
+	var panicErr *core.PanicError
+
+	if !ok || errors.As(err, &panicErr) {
+		return err
+	}
+
+	language := this.options.language
+
+	// The custom message is a locale key if the locale has it, otherwise a message template.
+	if resolved, foundLanguage, lookupErr := this.validator.locales.Resolve(language, text); lookupErr == nil {
+		text, language = resolved, foundLanguage
+	}
+
+	var original *core.Message
+
+	if !errors.As(err, &original) {
+		original = core.NewMessage("", "", this.value)
+	}
+
+	message := *original
+	message.Text = text
+	message.Language = language
+	message.Custom = true
+
+	return &message
 }
 
 func (this *context) setValue(normalized *core.NormalizedValue) {
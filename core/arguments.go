@@ -0,0 +1,46 @@
+package core
+
+import (
+	"github.com/typerandom/validator/core/parser"
+)
+
+// Arguments are the positional and keyword arguments of the method that a validator is called for, with references
+// resolved. Validators get them from ValidatorContext.Arguments. The positional arguments are also passed to the
+// validator directly, so validators that don't take keyword arguments don't need to look at them.
+type Arguments struct {
+	Positional []interface{}
+	Keywords   parser.Keywords
+}
+
+// NewArguments creates arguments from the positional and keyword arguments of a method.
+func NewArguments(positional []interface{}, keywords parser.Keywords) *Arguments {
+	return &Arguments{
+		Positional: positional,
+		Keywords:   keywords,
+	}
+}
+
+// Len returns the number of positional and keyword arguments.
+func (this *Arguments) Len() int {
+	return len(this.Positional) + len(this.Keywords)
+}
+
+// Keyword returns the value of the keyword argument with the specified name.
+func (this *Arguments) Keyword(name string) (interface{}, bool) {
+	return this.Keywords.Get(name)
+}
+
+// Get returns the keyword argument with the specified name, or the positional argument at the specified index if
+// there is no such keyword argument. This lets an argument be given either way, i.e. "time(´2006-01-02´)" or
+// "time(layout=´2006-01-02´)".
+func (this *Arguments) Get(index int, name string) (interface{}, bool) {
+	if value, ok := this.Keywords.Get(name); ok {
+		return value, true
+	}
+
+	if index >= 0 && index < len(this.Positional) {
+		return this.Positional[index], true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,38 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"testing"
+)
+
+func TestThatArgumentsCanBeGivenByPositionOrKeyword(t *testing.T) {
+	arguments := NewArguments([]interface{}{"a", int64(2)}, parser.Keywords{{Name: "c", Value: true}, {Name: "b", Value: "x"}})
+
+	if arguments.Len() != 4 {
+		t.Fatalf("Expected 4 arguments, but got %d.", arguments.Len())
+	}
+
+	tests := []struct {
+		index    int
+		name     string
+		expected interface{}
+		ok       bool
+	}{
+		{0, "a", "a", true},
+		{1, "b", "x", true},
+		{5, "c", true, true},
+		{2, "d", nil, false},
+		{-1, "", nil, false},
+	}
+
+	for _, test := range tests {
+		if value, ok := arguments.Get(test.index, test.name); value != test.expected || ok != test.ok {
+			t.Fatalf("Expected %v (%t) for %d or '%s', but got %v (%t).", test.expected, test.ok, test.index, test.name, value, ok)
+		}
+	}
+
+	if _, ok := arguments.Keyword("a"); ok {
+		t.Fatalf("Didn't expect positional arguments to be keyword arguments.")
+	}
+}
@@ -0,0 +1,334 @@
+package core
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core/parser"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LocaleBundle holds the locales of multiple languages, keyed by BCP 47 language tag.
+// Messages that are missing from a locale are resolved through its fallback chain, i.e. pt-BR -> pt -> en.
+// It's safe for concurrent use.
+type LocaleBundle struct {
+	defaultTag string
+	locales    map[string]*Locale
+	fallbacks  map[string]string
+	lock       sync.RWMutex
+}
+
+// NewLocaleBundle creates an empty bundle. The default tag is the last resort of every fallback chain.
+func NewLocaleBundle(defaultTag string) *LocaleBundle {
+	return &LocaleBundle{
+		defaultTag: NormalizeTag(defaultTag),
+		locales:    make(map[string]*Locale),
+		fallbacks:  make(map[string]string),
+	}
+}
+
+// NormalizeTag converts a language tag to the conventional BCP 47 casing and separator.
+// I.e. "PT_br" becomes "pt-BR" and "zh-hant-tw" becomes "zh-Hant-TW".
+func NormalizeTag(tag string) string {
+	subtags := strings.Split(strings.Replace(strings.TrimSpace(tag), "_", "-", -1), "-")
+
+	for i, subtag := range subtags {
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 2:
+			subtags[i] = strings.ToUpper(subtag)
+		case len(subtag) == 4:
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+
+	return strings.Join(subtags, "-")
+}
+
+// DefaultTag returns the tag of the language that every fallback chain ends with.
+func (this *LocaleBundle) DefaultTag() string {
+	return this.defaultTag
+}
+
+// Locale returns the locale of the specified language, or nil if the bundle doesn't contain it. Use EnsureLocale to
+// add a language.
+func (this *LocaleBundle) Locale(tag string) *Locale {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	return this.locales[NormalizeTag(tag)]
+}
+
+// EnsureLocale returns the locale of the specified language, adding an empty one if the bundle doesn't contain it yet.
+func (this *LocaleBundle) EnsureLocale(tag string) *Locale {
+	if locale := this.Locale(tag); locale != nil {
+		return locale
+	}
+
+	tag = NormalizeTag(tag)
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	if locale, ok := this.locales[tag]; ok {
+		return locale
+	}
+
+	locale := NewLocale()
+	this.locales[tag] = locale
+
+	return locale
+}
+
+// Has indicates whether or not the bundle contains a locale for the exact language tag.
+func (this *LocaleBundle) Has(tag string) bool {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	_, ok := this.locales[NormalizeTag(tag)]
+	return ok
+}
+
+// Tags returns the tags of all of the languages in the bundle in alphabetical order.
+func (this *LocaleBundle) Tags() []string {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	return this.tags()
+}
+
+func (this *LocaleBundle) tags() []string {
+	tags := make([]string, 0, len(this.locales))
+
+	for tag := range this.locales {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+// SetFallback overrides the language that is tried after the specified one. By default the fallback of a tag is the
+// tag with its last subtag removed, and the default tag for tags without subtags.
+func (this *LocaleBundle) SetFallback(tag string, fallback string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.fallbacks[NormalizeTag(tag)] = NormalizeTag(fallback)
+}
+
+// Chain returns the languages that are tried in order when resolving a message for the specified language.
+// The chain can contain languages that aren't in the bundle, those are skipped when resolving.
+func (this *LocaleBundle) Chain(tag string) []string {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	return this.chain(tag)
+}
+
+func (this *LocaleBundle) chain(tag string) []string {
+	var chain []string
+
+	seen := map[string]bool{}
+
+	for tag = NormalizeTag(tag); len(tag) > 0 && !seen[tag]; {
+		seen[tag] = true
+		chain = append(chain, tag)
+
+		if fallback, ok := this.fallbacks[tag]; ok {
+			tag = fallback
+		} else if i := strings.LastIndex(tag, "-"); i > 0 {
+			tag = tag[:i]
+		} else {
+			tag = this.defaultTag
+		}
+	}
+
+	return chain
+}
+
+// Get retrieves a message for the specified language, resolving it through the fallback chain of the language.
+func (this *LocaleBundle) Get(tag string, key string) (string, error) {
+	message, _, err := this.Resolve(tag, key)
+	return message, err
+}
+
+// Resolve works like Get, but also returns the tag of the language in the chain that the message was found in.
+func (this *LocaleBundle) Resolve(tag string, key string) (string, string, error) {
+	if message, foundTag, ok := this.Lookup(tag, key); ok {
+		return message, foundTag, nil
+	}
+
+	return "", "", errors.New("Locale " + key + " does not exist.")
+}
+
+// Lookup works like Resolve, but indicates whether or not the message exists instead of returning an error.
+func (this *LocaleBundle) Lookup(tag string, key string) (string, string, bool) {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	for _, chainTag := range this.chain(tag) {
+		if locale, ok := this.locales[chainTag]; ok {
+			if message, ok := locale.Lookup(key); ok {
+				return message, chainTag, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// Translate replaces the text of the message of an error by the message with the same key in the specified language,
+// if there is one. It's used for errors that are created with a default text because no locale is at hand when they
+// are created, such as those of the registry and the parser. The error is modified in place.
+func (this *LocaleBundle) Translate(err error, tag string) {
+	var message *Message
+
+	if errors.As(err, &message) {
+		if text, foundTag, ok := this.Lookup(tag, message.Key); ok {
+			message.Text = text
+			message.Language = foundTag
+		}
+		return
+	}
+
+	var parseErr *parser.ParseError
+
+	if errors.As(err, &parseErr) {
+		if text, _, ok := this.Lookup(tag, parseErr.Key); ok {
+			parseErr.Text = text
+		}
+	}
+}
+
+// Translated works like Translate, but translates a copy of the error, so that errors that are shared between calls,
+// such as cached ones, keep their text. Config errors are copied along with the error they wrap.
+func (this *LocaleBundle) Translated(err error, tag string) error {
+	switch typedErr := err.(type) {
+	case *ConfigError:
+		copied := *typedErr
+		copied.Err = this.Translated(typedErr.Err, tag)
+		return &copied
+	case *Message:
+		copied := *typedErr
+		this.Translate(&copied, tag)
+		return &copied
+	case *parser.ParseError:
+		copied := *typedErr
+		this.Translate(&copied, tag)
+		return &copied
+	}
+
+	return err
+}
+
+// Copy copies the bundle and the locales in it.
+func (this *LocaleBundle) Copy() *LocaleBundle {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	bundle := NewLocaleBundle(this.defaultTag)
+
+	for tag, locale := range this.locales {
+		bundle.locales[tag] = locale.Copy()
+	}
+
+	for tag, fallback := range this.fallbacks {
+		bundle.fallbacks[tag] = fallback
+	}
+
+	return bundle
+}
+
+// Negotiate returns the tag of the language in the bundle that best matches an HTTP Accept-Language header.
+// Each requested language is looked up in order of preference, first exactly, then with subtags removed and lastly
+// by any regional variant of the same language. Returns the default tag if nothing matches.
+func (this *LocaleBundle) Negotiate(acceptLanguage string) string {
+	requested := ParseAcceptLanguage(acceptLanguage)
+
+	for _, tag := range requested {
+		if tag == "*" {
+			return this.defaultTag
+		}
+
+		for candidate := tag; len(candidate) > 0; {
+			if this.Has(candidate) {
+				return candidate
+			}
+
+			i := strings.LastIndex(candidate, "-")
+
+			if i < 0 {
+				break
+			}
+
+			candidate = candidate[:i]
+		}
+
+		language := strings.SplitN(tag, "-", 2)[0]
+
+		for _, available := range this.Tags() {
+			if strings.HasPrefix(available, language+"-") {
+				return available
+			}
+		}
+	}
+
+	return this.defaultTag
+}
+
+// ParseAcceptLanguage parses an HTTP Accept-Language header into normalized language tags, ordered from the most
+// to the least preferred. Languages with a quality of zero are left out.
+func ParseAcceptLanguage(acceptLanguage string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var weighted []weightedTag
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+
+		if len(tag) == 0 {
+			continue
+		}
+
+		quality := 1.0
+
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+
+			if strings.HasPrefix(param, "q=") {
+				if parsed, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+
+		if quality > 0 {
+			if tag != "*" {
+				tag = NormalizeTag(tag)
+			}
+			weighted = append(weighted, weightedTag{tag, quality})
+		}
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].quality > weighted[j].quality
+	})
+
+	tags := make([]string, len(weighted))
+
+	for i, entry := range weighted {
+		tags[i] = entry.tag
+	}
+
+	return tags
+}
@@ -0,0 +1,297 @@
+package core_test
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/typerandom/validator/core"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestThatLanguageTagsAreNormalized(t *testing.T) {
+	tests := map[string]string{
+		"en":         "en",
+		"EN":         "en",
+		"pt_br":      "pt-BR",
+		"pt-br":      "pt-BR",
+		"zh-hant-tw": "zh-Hant-TW",
+		" es-419 ":   "es-419",
+	}
+
+	for tag, expected := range tests {
+		if normalized := NormalizeTag(tag); normalized != expected {
+			t.Fatalf("Expected '%s' to be normalized to '%s', but got '%s'.", tag, expected, normalized)
+		}
+	}
+}
+
+func TestThatLocaleBundleChainFallsBackThroughSubtagsToDefault(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+
+	if chain := fmt.Sprint(bundle.Chain("pt_BR")); chain != "[pt-BR pt en]" {
+		t.Fatalf("Expected chain '[pt-BR pt en]', but got '%s'.", chain)
+	}
+
+	if chain := fmt.Sprint(bundle.Chain("zh-Hant-TW")); chain != "[zh-Hant-TW zh-Hant zh en]" {
+		t.Fatalf("Expected chain '[zh-Hant-TW zh-Hant zh en]', but got '%s'.", chain)
+	}
+
+	if chain := fmt.Sprint(bundle.Chain("en")); chain != "[en]" {
+		t.Fatalf("Expected chain '[en]', but got '%s'.", chain)
+	}
+}
+
+func TestThatLocaleBundleChainUsesExplicitFallbacks(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+	bundle.SetFallback("pt-BR", "pt-PT")
+	bundle.SetFallback("pt", "es")
+	bundle.SetFallback("es", "pt-BR")
+
+	if chain := fmt.Sprint(bundle.Chain("pt-BR")); chain != "[pt-BR pt-PT pt es]" {
+		t.Fatalf("Expected chain '[pt-BR pt-PT pt es]', but got '%s'.", chain)
+	}
+}
+
+func TestThatLocaleBundleResolvesMissingKeysThroughChain(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+
+	bundle.EnsureLocale("en").Set("a", "en a")
+	bundle.EnsureLocale("en").Set("b", "en b")
+	bundle.EnsureLocale("en").Set("c", "en c")
+	bundle.EnsureLocale("pt").Set("b", "pt b")
+	bundle.EnsureLocale("pt-BR").Set("c", "pt-BR c")
+
+	for key, expected := range map[string]string{"a": "en a", "b": "pt b", "c": "pt-BR c"} {
+		if message, err := bundle.Get("pt-br", key); err != nil || message != expected {
+			t.Fatalf("Expected '%s' for key '%s', but got '%s' (%v).", expected, key, message, err)
+		}
+	}
+
+	if _, err := bundle.Get("pt-BR", "d"); err == nil || err.Error() != "Locale d does not exist." {
+		t.Fatalf("Expected missing locale error, but got '%v'.", err)
+	}
+
+	if fmt.Sprint(bundle.Tags()) != "[en pt pt-BR]" || !bundle.Has("pt_br") || bundle.Has("de") {
+		t.Fatalf("Expected bundle to have tags '[en pt pt-BR]', but got '%v'.", bundle.Tags())
+	}
+}
+
+func TestThatLookingUpUnknownLocalesDoesNotAddThem(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+	english := bundle.EnsureLocale("en")
+
+	if locale := bundle.Locale("xx"); locale != nil {
+		t.Fatalf("Expected no locale for 'xx', but got %v.", locale)
+	}
+
+	if bundle.Has("xx") || len(bundle.Tags()) != 1 || bundle.Negotiate("xx") != "en" {
+		t.Fatalf("Expected looking up 'xx' not to add it, but got the tags %v.", bundle.Tags())
+	}
+
+	if bundle.Locale("EN") != english || bundle.EnsureLocale("en") != english {
+		t.Fatal("Expected the existing locale to be returned.")
+	}
+}
+
+func TestThatLocaleBundleCopyHasDifferentLocales(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+	bundle.EnsureLocale("en").Set("a", "a")
+
+	bundleCopy := bundle.Copy()
+
+	if bundleCopy.Locale("en") == bundle.Locale("en") {
+		t.Fatal("Expected different locales, got same.")
+	}
+
+	if message, _ := bundleCopy.Get("en", "a"); message != "a" {
+		t.Fatalf("Expected copied message 'a', but got '%s'.", message)
+	}
+}
+
+func TestThatAcceptLanguageIsParsedInOrderOfPreference(t *testing.T) {
+	tags := ParseAcceptLanguage("fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5, ja;q=0")
+
+	if fmt.Sprint(tags) != "[fr-CH fr en de *]" {
+		t.Fatalf("Expected '[fr-CH fr en de *]', but got '%v'.", tags)
+	}
+
+	if tags := ParseAcceptLanguage("en;q=0.1,pt_br"); fmt.Sprint(tags) != "[pt-BR en]" {
+		t.Fatalf("Expected '[pt-BR en]', but got '%v'.", tags)
+	}
+
+	if tags := ParseAcceptLanguage(""); len(tags) != 0 {
+		t.Fatalf("Expected no tags, but got '%v'.", tags)
+	}
+}
+
+func TestThatLocaleBundleNegotiatesBestAvailableLanguage(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+
+	for _, tag := range []string{"en", "de", "pt-BR", "zh-Hant"} {
+		bundle.EnsureLocale(tag)
+	}
+
+	tests := map[string]string{
+		"de-AT, en;q=0.5":        "de",
+		"fr, pt;q=0.9, de;q=0.8": "pt-BR",
+		"zh-Hant-TW":             "zh-Hant",
+		"ja, *;q=0.1":            "en",
+		"ja":                     "en",
+		"":                       "en",
+		"en;q=0.5, de":           "de",
+	}
+
+	for header, expected := range tests {
+		if tag := bundle.Negotiate(header); tag != expected {
+			t.Fatalf("Expected '%s' for '%s', but got '%s'.", expected, header, tag)
+		}
+	}
+}
+
+func TestThatCopiedLocalesAreIndependent(t *testing.T) {
+	original := NewLocale()
+	original.Set("a", "A")
+
+	copied := original.Copy()
+	copied.Set("a", "Copied A")
+	copied.Set("b", "B")
+	original.Set("c", "C")
+
+	if message, _ := original.Get("a"); message != "A" {
+		t.Fatalf("Expected original message 'A', but got '%s'.", message)
+	}
+
+	if keys := fmt.Sprint(original.Keys()); keys != "[a c]" {
+		t.Fatalf("Expected original keys [a c], but got %s.", keys)
+	}
+
+	if keys := fmt.Sprint(copied.Keys()); keys != "[a b]" {
+		t.Fatalf("Expected copied keys [a b], but got %s.", keys)
+	}
+}
+
+func TestThatLocalesCanBeUsedConcurrently(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+	bundle.EnsureLocale("en").Set("a", "A")
+
+	var wait sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wait.Add(1)
+
+		go func(i int) {
+			defer wait.Done()
+
+			for j := 0; j < 100; j++ {
+				bundle.EnsureLocale("en").Set(fmt.Sprintf("key%d", i), "value")
+				bundle.EnsureLocale(fmt.Sprintf("x-%d", i)).Set("b", "B")
+
+				if message, err := bundle.Get("x-1", "a"); err != nil || message != "A" {
+					t.Errorf("Expected message 'A', but got '%s' (%v).", message, err)
+				}
+			}
+		}(i)
+	}
+
+	wait.Wait()
+}
+
+func TestThatLocaleBundleIsReloadedAtomically(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+	bundle.SetFallback("de-CH", "de")
+	english := bundle.EnsureLocale("en")
+	english.Set("a", "Old A")
+	english.Set("b", "Old B")
+	bundle.EnsureLocale("fr").Set("a", "Vieux A")
+
+	err := bundle.Reload(func(fresh *LocaleBundle) error {
+		if len(fresh.Tags()) != 0 || fmt.Sprint(fresh.Chain("de-CH")) != "[de-CH de en]" {
+			t.Fatalf("Expected an empty bundle with the same fallbacks, but got %v.", fresh.Tags())
+		}
+
+		fresh.EnsureLocale("en").Set("a", "New A")
+		fresh.EnsureLocale("de").Set("a", "Neues A")
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, but got '%v'.", err)
+	}
+
+	if message, _ := english.Get("a"); message != "New A" {
+		t.Fatalf("Expected previously retrieved locale to have the new message, but got '%s'.", message)
+	}
+
+	if _, err := bundle.Get("en", "b"); err == nil {
+		t.Fatal("Expected removed message to be gone, but it wasn't.")
+	}
+
+	if message, _ := bundle.Get("fr", "a"); message != "New A" {
+		t.Fatalf("Expected emptied French locale to fall back to English, but got '%s'.", message)
+	}
+
+	if message, _ := bundle.Get("de-CH", "a"); message != "Neues A" {
+		t.Fatalf("Expected new German message, but got '%s'.", message)
+	}
+
+	if err := bundle.Reload(func(fresh *LocaleBundle) error { return errors.New("broken") }); err == nil || err.Error() != "broken" {
+		t.Fatalf("Expected reload error, but got '%v'.", err)
+	}
+
+	if message, _ := english.Get("a"); message != "New A" {
+		t.Fatalf("Expected messages to be kept after a failed reload, but got '%s'.", message)
+	}
+}
+
+func TestThatLocaleWatcherReloadsChangedFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/en.json": {Data: []byte(`{"greeting": "Hello"}`)},
+	}
+
+	base := func(bundle *LocaleBundle) {
+		bundle.EnsureLocale("en").Set("base", "Base")
+	}
+
+	bundle := NewLocaleBundle("en")
+
+	if err := bundle.ReloadFS(fsys, "locales", base); err != nil {
+		t.Fatalf("Expected no error, but got '%v'.", err)
+	}
+
+	watcher := bundle.WatchFS(fsys, "locales", base, time.Hour)
+	defer watcher.Stop()
+
+	if reloaded, err := watcher.Check(); reloaded || err != nil {
+		t.Fatalf("Didn't expect a reload of unchanged files, but got %v (%v).", reloaded, err)
+	}
+
+	fsys["locales/en.json"] = &fstest.MapFile{Data: []byte(`{"greeting": "Hi"}`)}
+	fsys["locales/de/messages.po"] = &fstest.MapFile{Data: []byte("msgid \"greeting\"\nmsgstr \"Hallo\"\n")}
+
+	if reloaded, err := watcher.Check(); !reloaded || err != nil {
+		t.Fatalf("Expected a reload of changed files, but got %v (%v).", reloaded, err)
+	}
+
+	for tag, expected := range map[string]string{"en": "Hi", "de": "Hallo"} {
+		if message, _ := bundle.Get(tag, "greeting"); message != expected {
+			t.Fatalf("Expected greeting '%s', but got '%s'.", expected, message)
+		}
+	}
+
+	if message, _ := bundle.Get("de", "base"); message != "Base" {
+		t.Fatalf("Expected base message to be kept, but got '%s'.", message)
+	}
+
+	fsys["locales/en.json"] = &fstest.MapFile{Data: []byte(`{"greeting": 1}`)}
+
+	if reloaded, err := watcher.Check(); reloaded || err == nil || watcher.Err() != err {
+		t.Fatalf("Expected a failed reload, but got %v (%v).", reloaded, err)
+	}
+
+	if message, _ := bundle.Get("en", "greeting"); message != "Hi" {
+		t.Fatalf("Expected messages to be kept after a failed reload, but got '%s'.", message)
+	}
+}
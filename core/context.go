@@ -26,7 +26,17 @@ type ValidatorContext interface {
 	// I.e. if the type of the value set was *int8, then the OriginalKind would be int8.
 	OriginalKind() reflect.Kind
 
-	// NewError returns a formatted error based on a locale key and format arguments.
+	// Arguments returns the positional and keyword arguments of the method that the validator is called for, i.e.
+	// "min=3" and "max=16" in "len(min=3, max=16)".
+	Arguments() *Arguments
+
+	// NewError returns an error with the message of a locale key. The message is rendered when the error is
+	// displayed, see MessageData for the placeholders it can use. Arguments of type Params provide named
+	// placeholders, i.e. NewError("min.cannotBeLessThan", Params{"min": 5}), all other arguments are positional.
 	// If the locale key does not exist, then an error is returned.
 	NewError(localeKey string, args ...interface{}) error
+
+	// NewConfigError works like NewError, but returns a *ConfigError. Use it for mistakes in the validate tag,
+	// such as invalid arguments, so that they can be told apart from invalid input.
+	NewConfigError(localeKey string, args ...interface{}) error
 }
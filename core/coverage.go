@@ -0,0 +1,281 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LocaleIssueKind is the kind of problem that was found when comparing locales.
+type LocaleIssueKind int
+
+const (
+	// MissingKey is reported for keys that the reference locale, or a validator, has but the locale doesn't.
+	MissingKey LocaleIssueKind = iota
+
+	// ExtraKey is reported for keys that the locale has but the reference locale doesn't.
+	ExtraKey
+
+	// PlaceholderMismatch is reported for messages that reference other placeholders than the reference message.
+	PlaceholderMismatch
+)
+
+func (this LocaleIssueKind) String() string {
+	switch this {
+	case MissingKey:
+		return "missing key"
+	case ExtraKey:
+		return "extra key"
+	default:
+		return "placeholder mismatch"
+	}
+}
+
+// LocaleIssue is a problem that was found in the locale of a language.
+type LocaleIssue struct {
+	Kind     LocaleIssueKind
+	Language string
+	Key      string
+
+	// Validator is the name of the validator that uses the key, if the issue was found by CheckLocaleKeys.
+	Validator string
+
+	// Expected and Actual are the placeholders of the reference message and of the message in the locale.
+	Expected []string
+	Actual   []string
+}
+
+func (this *LocaleIssue) Error() string {
+	switch this.Kind {
+	case MissingKey:
+		if this.Validator != "" {
+			return fmt.Sprintf("%s: missing key '%s' used by validator '%s'.", this.Language, this.Key, this.Validator)
+		}
+		return fmt.Sprintf("%s: missing key '%s'.", this.Language, this.Key)
+	case ExtraKey:
+		return fmt.Sprintf("%s: extra key '%s' that the reference locale doesn't have.", this.Language, this.Key)
+	default:
+		return fmt.Sprintf("%s: key '%s' has placeholders [%s], but the reference message has [%s].", this.Language, this.Key, strings.Join(this.Actual, " "), strings.Join(this.Expected, " "))
+	}
+}
+
+// LocaleIssues is a list of locale issues, which is returned as a single error.
+type LocaleIssues []*LocaleIssue
+
+func (this LocaleIssues) Error() string {
+	messages := make([]string, len(this))
+
+	for i, issue := range this {
+		messages[i] = issue.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+var (
+	printfVerbPattern    = regexp.MustCompile(`%[-+#0]*[0-9]*(\.[0-9]+)?[a-zA-Z]`)
+	templateFieldPattern = regexp.MustCompile(`\.(Field|Validator|Value)\b`)
+	templateParamPattern = regexp.MustCompile(`\.Params\.([A-Za-z0-9_]+)`)
+	templateIndexPattern = regexp.MustCompile(`index\s+\.Args\s+([0-9]+)`)
+)
+
+// MessagePlaceholders returns the sorted names of the placeholders that a message references, including those of
+// plural forms. Messages rendered as a text/template report the fields and parameters that they use by the same
+// names, and printf verbs of legacy messages are reported as %1, %2...
+func MessagePlaceholders(text string) []string {
+	names := map[string]bool{}
+
+	if strings.Contains(text, "{{") && isTemplate(text) {
+		for _, match := range templateFieldPattern.FindAllStringSubmatch(text, -1) {
+			names[strings.ToLower(match[1])] = true
+		}
+		for _, match := range templateParamPattern.FindAllStringSubmatch(text, -1) {
+			names[match[1]] = true
+		}
+		for _, match := range templateIndexPattern.FindAllStringSubmatch(text, -1) {
+			names[match[1]] = true
+		}
+	} else {
+		collectPlaceholders(text, names)
+	}
+
+	for i := range printfVerbPattern.FindAllString(strings.Replace(text, "%%", "", -1), -1) {
+		names[fmt.Sprintf("%%%d", i+1)] = true
+	}
+
+	placeholders := make([]string, 0, len(names))
+
+	for name := range names {
+		placeholders = append(placeholders, name)
+	}
+
+	sort.Strings(placeholders)
+
+	return placeholders
+}
+
+func isTemplate(text string) bool {
+	_, err := getTemplate(text)
+	return err == nil
+}
+
+func collectPlaceholders(text string, names map[string]bool) {
+	for i := 0; i < len(text); i++ {
+		if text[i] != '{' {
+			continue
+		}
+
+		end := matchingBrace(text, i)
+
+		if end < 0 {
+			return
+		}
+
+		inner := text[i+1 : end]
+
+		if parts := strings.SplitN(inner, ",", 3); len(parts) == 3 && strings.TrimSpace(parts[1]) == "plural" {
+			names[strings.TrimSpace(parts[0])] = true
+
+			forms := parts[2]
+
+			for start := strings.IndexByte(forms, '{'); start >= 0; start = strings.IndexByte(forms, '{') {
+				formEnd := matchingBrace(forms, start)
+
+				if formEnd < 0 {
+					break
+				}
+
+				collectPlaceholders(forms[start+1:formEnd], names)
+				forms = forms[formEnd+1:]
+			}
+		} else {
+			names[strings.TrimSpace(inner)] = true
+		}
+
+		i = end
+	}
+}
+
+func samePlaceholders(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// compareLocale compares the messages of a language to the reference locale. Missing keys are those that lookup
+// can't find, extra keys and placeholders are only checked for the keys that the locale itself has.
+func compareLocale(language string, reference *Locale, locale *Locale, lookup func(key string) bool) LocaleIssues {
+	var issues LocaleIssues
+
+	for _, key := range reference.Keys() {
+		if !lookup(key) {
+			issues = append(issues, &LocaleIssue{Kind: MissingKey, Language: language, Key: key})
+		}
+	}
+
+	for _, key := range locale.Keys() {
+		referenceText, ok := reference.Lookup(key)
+
+		if !ok {
+			issues = append(issues, &LocaleIssue{Kind: ExtraKey, Language: language, Key: key})
+			continue
+		}
+
+		text, _ := locale.Lookup(key)
+		expected, actual := MessagePlaceholders(referenceText), MessagePlaceholders(text)
+
+		if !samePlaceholders(expected, actual) {
+			issues = append(issues, &LocaleIssue{Kind: PlaceholderMismatch, Language: language, Key: key, Expected: expected, Actual: actual})
+		}
+	}
+
+	return issues
+}
+
+// CompareLocales compares the locale of a language to a reference locale, i.e. the English one. It reports the keys
+// that are missing or extra, and the messages whose placeholders differ from those of the reference message.
+func CompareLocales(language string, reference *Locale, locale *Locale) LocaleIssues {
+	return compareLocale(language, reference, locale, func(key string) bool {
+		_, ok := locale.Lookup(key)
+		return ok
+	})
+}
+
+// CheckCoverage compares the locales of all languages in the bundle to the locale of the default language.
+// Keys aren't reported as missing if another language in the fallback chain has them, other than the default one,
+// i.e. pt-BR doesn't need to repeat the messages of pt.
+func (this *LocaleBundle) CheckCoverage() LocaleIssues {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	var issues LocaleIssues
+
+	reference, ok := this.locales[this.defaultTag]
+
+	if !ok {
+		reference = NewLocale()
+	}
+
+	for _, tag := range this.tags() {
+		if tag == this.defaultTag {
+			continue
+		}
+
+		chain := this.chain(tag)
+
+		issues = append(issues, compareLocale(tag, reference, this.locales[tag], func(key string) bool {
+			for _, chainTag := range chain {
+				if chainTag == this.defaultTag {
+					continue
+				}
+				if locale, ok := this.locales[chainTag]; ok {
+					if _, ok := locale.Lookup(key); ok {
+						return true
+					}
+				}
+			}
+			return false
+		})...)
+	}
+
+	return issues
+}
+
+// CheckLocaleKeys verifies that every locale key that the registered validators declare in their signatures can be
+// resolved in every language of the bundle.
+func CheckLocaleKeys(locales *LocaleBundle, signatures SignatureRegistry) LocaleIssues {
+	var issues LocaleIssues
+
+	names := make([]string, 0, len(signatures))
+
+	for name := range signatures {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	tags := locales.Tags()
+
+	if len(tags) == 0 {
+		tags = []string{locales.DefaultTag()}
+	}
+
+	for _, tag := range tags {
+		for _, name := range names {
+			for _, key := range signatures[name].LocaleKeys {
+				if _, _, ok := locales.Lookup(tag, key); !ok {
+					issues = append(issues, &LocaleIssue{Kind: MissingKey, Language: tag, Key: key, Validator: name})
+				}
+			}
+		}
+	}
+
+	return issues
+}
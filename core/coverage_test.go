@@ -0,0 +1,80 @@
+package core_test
+
+import (
+	"fmt"
+	. "github.com/typerandom/validator/core"
+	"testing"
+)
+
+func TestThatMessagePlaceholdersAreFound(t *testing.T) {
+	tests := map[string]string{
+		"{field} cannot be {forbidden}.":                                "[field forbidden]",
+		"{field} needs {min, plural, one {# {unit}} other {# {unit}s}}": "[field min unit]",
+		"{{.Field}} needs {{.Params.min}} of {{index .Args 0}}.":        "[0 field min]",
+		"Field '%s' needs %d%% of %v.":                                  "[%1 %2 %3]",
+		"No placeholders.":                                              "[]",
+	}
+
+	for text, expected := range tests {
+		if placeholders := fmt.Sprint(MessagePlaceholders(text)); placeholders != expected {
+			t.Fatalf("Expected placeholders %s of '%s', but got %s.", expected, text, placeholders)
+		}
+	}
+}
+
+func TestThatLocalesCanBeCompared(t *testing.T) {
+	reference := NewLocale()
+	reference.Set("a", "{field} is {min}.")
+	reference.Set("b", "{field} is b.")
+
+	locale := NewLocale()
+	locale.Set("a", "{field} ist {max}.")
+	locale.Set("c", "Extra.")
+
+	issues := CompareLocales("de", reference, locale)
+
+	expected := []string{
+		"de: missing key 'b'.",
+		"de: key 'a' has placeholders [field max], but the reference message has [field min].",
+		"de: extra key 'c' that the reference locale doesn't have.",
+	}
+
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %d issues, but got:\n%s", len(expected), issues)
+	}
+
+	for i, issue := range issues {
+		if issue.Error() != expected[i] {
+			t.Fatalf("Expected issue '%s', but got '%s'.", expected[i], issue)
+		}
+	}
+}
+
+func TestThatLocaleBundleCoverageFollowsFallbackChains(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+	bundle.EnsureLocale("en").Set("a", "A.")
+	bundle.EnsureLocale("en").Set("b", "B.")
+	bundle.EnsureLocale("pt").Set("a", "A.")
+	bundle.EnsureLocale("pt-BR").Set("b", "B.")
+
+	issues := bundle.CheckCoverage()
+
+	if len(issues) != 1 || issues[0].Kind != MissingKey || issues[0].Language != "pt" || issues[0].Key != "b" {
+		t.Fatalf("Expected only 'b' to be missing in 'pt', but got:\n%s", issues)
+	}
+}
+
+func TestThatLocaleKeysOfSignaturesAreChecked(t *testing.T) {
+	bundle := NewLocaleBundle("en")
+	bundle.EnsureLocale("en").Set("a", "A.")
+	bundle.EnsureLocale("de")
+
+	signatures := NewSignatureRegistry()
+	signatures.Register("x", NewSignature().WithLocaleKeys("a", "b"))
+
+	issues := CheckLocaleKeys(bundle, signatures)
+
+	if issues.Error() != "de: missing key 'b' used by validator 'x'.\nen: missing key 'b' used by validator 'x'." {
+		t.Fatalf("Expected 'b' to be missing in all languages, but got:\n%s", issues)
+	}
+}
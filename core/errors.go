@@ -1,8 +1,11 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -10,6 +13,7 @@ type Error struct {
 	field     *ReflectedField
 	validator *parser.Method
 	src       error
+	branch    []int
 }
 
 func NewError(field *ReflectedField, validator *parser.Method, err error) *Error {
@@ -30,6 +34,12 @@ func (this Error) IsFieldError() bool {
 	return this.field != nil && this.validator != nil
 }
 
+// IsConfigError indicates whether or not the error is caused by a mistake in the validation setup,
+// such as an unknown validator or invalid validator arguments, rather than by invalid input.
+func (this *Error) IsConfigError() bool {
+	return IsConfigError(this.src)
+}
+
 func (this *Error) GetFieldName() string {
 	if this.field == nil {
 		return ""
@@ -51,11 +61,52 @@ func (this *Error) GetValidatorName() string {
 	return this.validator.Name
 }
 
+// GetBranch returns the alternatives of the tag that the failed method was evaluated in, as the 1-based index of the
+// alternative that was taken at every '|' from the top of the tag down to the method. I.e. [2, 1] for numeric in
+// "empty | (numeric | uuid), max(10)". Returns nil if the method isn't inside of any alternative.
+func (this *Error) GetBranch() []int {
+	return this.branch
+}
+
+// SetBranch sets the alternatives of the tag that the failed method was evaluated in, see GetBranch.
+func (this *Error) SetBranch(branch []int) {
+	this.branch = branch
+}
+
+// Unwrap returns the underlying error that was returned by the validator.
+func (this *Error) Unwrap() error {
+	return this.src
+}
+
 func (this *Error) String() string {
 	return this.Error()
 }
 
+// GetLocaleKey returns the locale key of the message of the error, which can be used as a stable error code.
+// Returns an empty string if the error wasn't created from a locale message.
+func (this *Error) GetLocaleKey() string {
+	var message *Message
+
+	if errors.As(this.src, &message) {
+		return message.Key
+	}
+
+	var parseErr *parser.ParseError
+
+	if errors.As(this.src, &parseErr) {
+		return parseErr.Key
+	}
+
+	return ""
+}
+
 func (this *Error) Error() string {
+	var message *Message
+
+	if this.IsFieldError() && errors.As(this.src, &message) {
+		return message.Render(this.GetFieldDisplayName(), this.GetValidatorName())
+	}
+
 	if this.IsFieldError() {
 		message := strings.Replace(this.src.Error(), "{field}", this.GetFieldDisplayName(), 1)
 		message = strings.Replace(message, "{validator}", this.GetValidatorName(), 1)
@@ -65,6 +116,98 @@ func (this *Error) Error() string {
 	}
 }
 
+// PanicError is the error that a panic inside of a validator is converted to.
+type PanicError struct {
+	// Value is the value that was passed to panic.
+	Value interface{}
+
+	// Stack is the stack trace of the goroutine at the time of the panic.
+	Stack []byte
+
+	// Message is the message that the error is displayed with, which has the panic value as {panic}.
+	Message *Message
+}
+
+func NewPanicError(value interface{}, stack []byte) *PanicError {
+	return &PanicError{
+		Value:   value,
+		Stack:   stack,
+		Message: NewMessage("validator.panicked", "Validator '{validator}' on field '{field}' panicked: {panic}", nil, Params{"panic": value}),
+	}
+}
+
+func (this *PanicError) Error() string {
+	return this.Message.Error()
+}
+
+// Unwrap returns the message of the error, so that it can be translated like other messages.
+func (this *PanicError) Unwrap() error {
+	return this.Message
+}
+
+// ConfigError is the error for mistakes in the validation setup, such as unknown validators or invalid validator
+// arguments. It allows tooling to tell configuration mistakes apart from invalid input.
+type ConfigError struct {
+	// Type is the struct type that the mistake was found in, if known.
+	Type reflect.Type
+
+	// Field is the name of the field that the mistake was found in, if known.
+	Field string
+
+	Err error
+}
+
+func NewConfigError(err error) *ConfigError {
+	return &ConfigError{
+		Err: err,
+	}
+}
+
+// Location returns the struct type and field that the mistake was found in, i.e. "main.User.Email".
+func (this *ConfigError) Location() string {
+	var location []string
+
+	if this.Type != nil {
+		location = append(location, this.Type.String())
+	}
+
+	if len(this.Field) > 0 {
+		location = append(location, this.Field)
+	}
+
+	return strings.Join(location, ".")
+}
+
+func (this *ConfigError) Error() string {
+	if location := this.Location(); len(location) > 0 {
+		return location + ": " + this.Err.Error()
+	}
+	return this.Err.Error()
+}
+
+func (this *ConfigError) Unwrap() error {
+	return this.Err
+}
+
+// IsConfigError indicates whether or not err is, or wraps, a *ConfigError.
+func IsConfigError(err error) bool {
+	var configErr *ConfigError
+	return errors.As(err, &configErr)
+}
+
+// ConfigErrors is a list of configuration mistakes that can be returned as a single error.
+type ConfigErrors []*ConfigError
+
+func (this ConfigErrors) Error() string {
+	messages := make([]string, len(this))
+
+	for i, err := range this {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
 type ErrorList []*Error
 
 func (this *ErrorList) AddPlain(err error) {
@@ -109,6 +252,131 @@ func (this ErrorList) WithValidator(validatorTagName string) ErrorList {
 	return errs
 }
 
+// WithoutField returns the errors that don't belong to the field with the specified full name.
+func (this ErrorList) WithoutField(fieldName string) ErrorList {
+	return this.Filter(func(err *Error) bool {
+		return !err.IsFieldError() || err.GetFieldName() != fieldName
+	})
+}
+
+// WithoutValidator returns the errors that weren't produced by the specified validator.
+func (this ErrorList) WithoutValidator(validatorTagName string) ErrorList {
+	return this.Filter(func(err *Error) bool {
+		return !err.IsFieldError() || err.GetValidatorName() != validatorTagName
+	})
+}
+
+// Under returns the errors of the field with the specified path and of all fields nested beneath it.
+// I.e. Under("Address") matches both "Address" and "Address.Street", but not "AddressLine".
+func (this ErrorList) Under(path string) ErrorList {
+	return this.Filter(func(err *Error) bool {
+		return err.IsFieldError() && isFieldPathUnder(err.GetFieldName(), path)
+	})
+}
+
+// NotUnder returns all errors except those that Under would return for the same path.
+func (this ErrorList) NotUnder(path string) ErrorList {
+	return this.Filter(func(err *Error) bool {
+		return !err.IsFieldError() || !isFieldPathUnder(err.GetFieldName(), path)
+	})
+}
+
+// Filter returns the errors for which keep returns true.
+func (this ErrorList) Filter(keep func(*Error) bool) ErrorList {
+	var errs ErrorList
+
+	for _, err := range this {
+		if keep(err) {
+			errs.Add(err)
+		}
+	}
+
+	return errs
+}
+
+// ByField groups the field errors by the full name of their field. Plain errors are left out.
+func (this ErrorList) ByField() map[string][]*Error {
+	grouped := map[string][]*Error{}
+
+	for _, err := range this {
+		if err.IsFieldError() {
+			fieldName := err.GetFieldName()
+			grouped[fieldName] = append(grouped[fieldName], err)
+		}
+	}
+
+	return grouped
+}
+
+// Unique returns the errors with duplicates removed, keeping the first occurrence of each.
+// Two errors are duplicates if they have the same field, validator and message.
+func (this ErrorList) Unique() ErrorList {
+	var errs ErrorList
+
+	seen := map[string]bool{}
+
+	for _, err := range this {
+		key := err.GetFieldName() + "\x00" + err.GetValidatorName() + "\x00" + err.Error()
+		if !seen[key] {
+			seen[key] = true
+			errs.Add(err)
+		}
+	}
+
+	return errs
+}
+
+// Sorted returns a copy of the errors ordered by the declaration order of their fields, and by the order of the
+// elements of arrays, slices and maps that they were found in. Plain errors come first, and errors of the same field
+// keep their relative order.
+func (this ErrorList) Sorted() ErrorList {
+	errs := make(ErrorList, len(this))
+	copy(errs, this)
+
+	paths := make(map[*Error][]int, len(errs))
+
+	for _, err := range errs {
+		paths[err] = err.fieldIndexPath()
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		return compareIndexPaths(paths[errs[i]], paths[errs[j]]) < 0
+	})
+
+	return errs
+}
+
+// Tree returns a tree view of the errors that mirrors the shape of the validated structure.
+// Plain errors are attached to the root node.
+func (this ErrorList) Tree() *ErrorTree {
+	root := &ErrorTree{}
+
+	for _, err := range this {
+		if !err.IsFieldError() {
+			root.Errors.Add(err)
+			continue
+		}
+
+		var chain []*ReflectedField
+
+		for field := err.field; field != nil; field = field.Parent {
+			chain = append(chain, field)
+		}
+
+		node := root
+
+		for i := len(chain) - 1; i >= 0; i-- {
+			node = node.childFor(chain[i])
+		}
+
+		node.Errors.Add(err)
+	}
+
+	root.sortChildren()
+
+	return root
+}
+
 func (this ErrorList) Any() bool {
 	return len(this) > 0
 }
@@ -129,3 +397,117 @@ func (this ErrorList) PrintAll() {
 		fmt.Println(err)
 	}
 }
+
+// ErrorTree is a node of the tree view of an ErrorList. See ErrorList.Tree().
+type ErrorTree struct {
+	// Name is the name of the field that this node represents. Empty for the root node.
+	Name string
+
+	// Field is the field that this node represents. Nil for the root node.
+	Field *ReflectedField
+
+	// Errors contains the errors of this exact field, not including the ones of nested fields.
+	Errors ErrorList
+
+	// Children contains the nodes of nested fields in declaration order.
+	Children []*ErrorTree
+}
+
+// Child returns the direct child node with the specified field name, or nil if there is none.
+func (this *ErrorTree) Child(name string) *ErrorTree {
+	for _, child := range this.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// Find returns the node at the specified dot separated field path, or nil if there is none.
+func (this *ErrorTree) Find(path string) *ErrorTree {
+	node := this
+
+	for _, name := range strings.Split(path, ".") {
+		if node = node.Child(name); node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+// All returns the errors of this node and of all nested nodes in declaration order.
+func (this *ErrorTree) All() ErrorList {
+	errs := append(ErrorList{}, this.Errors...)
+
+	for _, child := range this.Children {
+		errs.AddMany(child.All())
+	}
+
+	return errs
+}
+
+// Any indicates whether or not there are any errors in this node or in any nested node.
+func (this *ErrorTree) Any() bool {
+	if this.Errors.Any() {
+		return true
+	}
+
+	for _, child := range this.Children {
+		if child.Any() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (this *ErrorTree) childFor(field *ReflectedField) *ErrorTree {
+	if child := this.Child(field.Name); child != nil {
+		return child
+	}
+
+	child := &ErrorTree{
+		Name:  field.Name,
+		Field: field,
+	}
+
+	this.Children = append(this.Children, child)
+
+	return child
+}
+
+func (this *ErrorTree) sortChildren() {
+	sort.SliceStable(this.Children, func(i, j int) bool {
+		return this.Children[i].Field.Index < this.Children[j].Field.Index
+	})
+
+	for _, child := range this.Children {
+		child.sortChildren()
+	}
+}
+
+// fieldIndexPath returns the indexes of the field and its parents, starting from the outermost field. The indexes of
+// the elements that a field was found in follow the index of the field they belong to.
+func (this *Error) fieldIndexPath() []int {
+	var path []int
+
+	for field := this.field; field != nil; field = field.Parent {
+		path = append(append([]int{field.Index}, field.Elements...), path...)
+	}
+
+	return path
+}
+
+func compareIndexPaths(a []int, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return len(a) - len(b)
+}
+
+func isFieldPathUnder(fieldName string, path string) bool {
+	return fieldName == path || strings.HasPrefix(fieldName, path+".")
+}
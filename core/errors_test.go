@@ -4,6 +4,7 @@ import (
 	"errors"
 	. "github.com/typerandom/validator/core"
 	"github.com/typerandom/validator/core/parser"
+	"reflect"
 	"testing"
 )
 
@@ -166,3 +167,176 @@ func TestThatErrorListForFieldOnlyReturnsTheSpecifiedField(t *testing.T) {
 		t.Fatalf("Expected one error, but got %d.", len(userFieldFirstNameErrors))
 	}
 }
+
+func newErrorListViewDummy() (ErrorList, map[string]*Error) {
+	var errs ErrorList
+
+	userField := &ReflectedField{Index: 0, Name: "User"}
+	addressField := &ReflectedField{Index: 2, Parent: userField, Name: "Address"}
+
+	named := map[string]*Error{
+		"plain":          NewPlainError(errors.New("Ooops.")),
+		"street":         NewError(&ReflectedField{Index: 0, Parent: addressField, Name: "Street"}, &parser.Method{Name: "not_empty"}, errors.New("{field} cannot be empty.")),
+		"addressLine":    NewError(&ReflectedField{Index: 3, Parent: userField, Name: "AddressLine"}, &parser.Method{Name: "min"}, errors.New("{field} is too short.")),
+		"name":           NewError(&ReflectedField{Index: 1, Parent: userField, Name: "Name"}, &parser.Method{Name: "not_empty"}, errors.New("{field} cannot be empty.")),
+		"nameDuplicate":  NewError(&ReflectedField{Index: 1, Parent: userField, Name: "Name"}, &parser.Method{Name: "not_empty"}, errors.New("{field} cannot be empty.")),
+		"address":        NewError(addressField, &parser.Method{Name: "not_nil"}, errors.New("{field} cannot be nil.")),
+		"addressZipCode": NewError(&ReflectedField{Index: 1, Parent: addressField, Name: "ZipCode"}, &parser.Method{Name: "numeric"}, errors.New("{field} must be numeric.")),
+	}
+
+	for _, name := range []string{"street", "addressLine", "name", "plain", "nameDuplicate", "address", "addressZipCode"} {
+		errs.Add(named[name])
+	}
+
+	return errs, named
+}
+
+func testThatErrorListContainsExactly(t *testing.T, errs ErrorList, expected ...*Error) {
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d errors, but got %d.", len(expected), len(errs))
+	}
+
+	for i, err := range expected {
+		if errs[i] != err {
+			t.Fatalf("Expected error %d to be '%s', but got '%s'.", i, err, errs[i])
+		}
+	}
+}
+
+func TestThatErrorListUnderOnlyReturnsErrorsOfFieldAndNestedFields(t *testing.T) {
+	errs, named := newErrorListViewDummy()
+
+	testThatErrorListContainsExactly(t, errs.Under("User.Address"), named["street"], named["address"], named["addressZipCode"])
+}
+
+func TestThatErrorListNotUnderExcludesErrorsOfFieldAndNestedFields(t *testing.T) {
+	errs, named := newErrorListViewDummy()
+
+	testThatErrorListContainsExactly(t, errs.NotUnder("User.Address"), named["addressLine"], named["name"], named["plain"], named["nameDuplicate"])
+}
+
+func TestThatErrorListWithoutFieldAndValidatorExcludesMatchingErrors(t *testing.T) {
+	errs, named := newErrorListViewDummy()
+
+	testThatErrorListContainsExactly(t, errs.WithoutField("User.Name").WithoutValidator("not_empty"), named["addressLine"], named["plain"], named["address"], named["addressZipCode"])
+}
+
+func TestThatErrorListByFieldGroupsFieldErrors(t *testing.T) {
+	errs, named := newErrorListViewDummy()
+
+	grouped := errs.ByField()
+
+	if len(grouped) != 5 {
+		t.Fatalf("Expected 5 fields, but got %d.", len(grouped))
+	}
+
+	testThatErrorListContainsExactly(t, grouped["User.Name"], named["name"], named["nameDuplicate"])
+}
+
+func TestThatErrorListUniqueRemovesDuplicates(t *testing.T) {
+	errs, named := newErrorListViewDummy()
+
+	testThatErrorListContainsExactly(t, errs.WithField("User.Name").Unique(), named["name"])
+
+	if len(errs.Unique()) != len(errs)-1 {
+		t.Fatalf("Expected %d errors, but got %d.", len(errs)-1, len(errs.Unique()))
+	}
+}
+
+func TestThatErrorListSortedOrdersByDeclarationOrder(t *testing.T) {
+	errs, named := newErrorListViewDummy()
+
+	testThatErrorListContainsExactly(t, errs.Sorted(), named["plain"], named["name"], named["nameDuplicate"], named["address"], named["street"], named["addressZipCode"], named["addressLine"])
+
+	if errs.First() != named["street"] {
+		t.Fatal("Expected sorting to leave the original list untouched, but it didn't.")
+	}
+}
+
+func TestThatErrorListSortedOrdersElementsByIndex(t *testing.T) {
+	itemsField := &ReflectedField{Index: 1, Name: "Items"}
+
+	elementErr := func(elements []int, index int, name string) *Error {
+		element := *itemsField
+		element.Elements = elements
+		return NewError(&ReflectedField{Index: index, Parent: &element, Name: name}, &parser.Method{Name: "not_empty"}, errors.New("{field} cannot be empty."))
+	}
+
+	items := NewError(itemsField, &parser.Method{Name: "min"}, errors.New("{field} is too short."))
+	third := elementErr([]int{2}, 0, "Name")
+	first := elementErr([]int{0}, 1, "Code")
+	firstName := elementErr([]int{0}, 0, "Name")
+	nested := elementErr([]int{1, 3}, 0, "Name")
+	nestedFirst := elementErr([]int{1, 0}, 0, "Name")
+
+	errs := ErrorList{third, nested, first, items, nestedFirst, firstName}
+
+	testThatErrorListContainsExactly(t, errs.Sorted(), items, firstName, first, nestedFirst, nested, third)
+}
+
+func TestThatErrorListTreeMirrorsStructure(t *testing.T) {
+	errs, named := newErrorListViewDummy()
+
+	tree := errs.Tree()
+
+	testThatErrorListContainsExactly(t, tree.Errors, named["plain"])
+
+	user := tree.Child("User")
+
+	if user == nil || len(user.Children) != 3 {
+		t.Fatal("Expected 'User' node with 3 children, but didn't get it.")
+	}
+
+	for i, name := range []string{"Name", "Address", "AddressLine"} {
+		if user.Children[i].Name != name {
+			t.Fatalf("Expected child %d to be '%s', but got '%s'.", i, name, user.Children[i].Name)
+		}
+	}
+
+	address := tree.Find("User.Address")
+
+	if address == nil {
+		t.Fatal("Expected to find 'User.Address' node, but didn't.")
+	}
+
+	testThatErrorListContainsExactly(t, address.Errors, named["address"])
+	testThatErrorListContainsExactly(t, address.All(), named["address"], named["street"], named["addressZipCode"])
+
+	if tree.Find("User.Address.Country") != nil {
+		t.Fatal("Didn't expect to find 'User.Address.Country' node, but did.")
+	}
+
+	if !tree.Any() || (&ErrorTree{}).Any() {
+		t.Fatal("Expected only the filled tree to have errors.")
+	}
+}
+
+func TestThatConfigErrorIsDetectedThroughWrapping(t *testing.T) {
+	err := NewError(&ReflectedField{Name: "Value"}, &parser.Method{Name: "min"}, NewConfigError(errors.New("{field} is misconfigured.")))
+
+	if !err.IsConfigError() || !IsConfigError(err) {
+		t.Fatal("Expected config error, but it wasn't.")
+	}
+
+	if err.Error() != "Value is misconfigured." {
+		t.Fatalf("Expected 'Value is misconfigured.', but got '%s'.", err)
+	}
+
+	if NewError(&ReflectedField{}, &parser.Method{}, errors.New("")).IsConfigError() {
+		t.Fatal("Didn't expect config error, but it was.")
+	}
+}
+
+func TestThatConfigErrorIncludesLocation(t *testing.T) {
+	err := &ConfigError{Type: reflect.TypeOf(ReflectedField{}), Field: "Name", Err: errors.New("Oops.")}
+
+	if expectedErr := "core.ReflectedField.Name: Oops."; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', but got '%s'.", expectedErr, err)
+	}
+
+	errs := ConfigErrors{err, NewConfigError(errors.New("Another."))}
+
+	if expectedErr := "core.ReflectedField.Name: Oops.\nAnother."; errs.Error() != expectedErr {
+		t.Fatalf("Expected '%s', but got '%s'.", expectedErr, errs)
+	}
+}
@@ -0,0 +1,331 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// LocaleLoadError is returned when a locale file can't be loaded. It points at the file, and where known, the line
+// and key of the bad entry.
+type LocaleLoadError struct {
+	File string
+	Line int
+	Key  string
+	Err  error
+}
+
+func (this *LocaleLoadError) Error() string {
+	location := this.File
+
+	if this.Line > 0 {
+		location += ":" + strconv.Itoa(this.Line)
+	}
+
+	if this.Key != "" {
+		return fmt.Sprintf("%s: key '%s': %v", location, this.Key, this.Err)
+	}
+
+	return fmt.Sprintf("%s: %v", location, this.Err)
+}
+
+func (this *LocaleLoadError) Unwrap() error {
+	return this.Err
+}
+
+// localeLoaders maps the supported file extensions to the functions that load them.
+var localeLoaders = map[string]func(locale *Locale, file string, data []byte) error{
+	".json": loadJsonLocale,
+	".po":   loadPoLocale,
+}
+
+func isLocaleFile(name string) bool {
+	_, ok := localeLoaders[strings.ToLower(path.Ext(name))]
+	return ok
+}
+
+// LoadFS loads the messages of a file in the file system, i.e. one embedded with embed.FS. The format is determined
+// by the extension of the file: ".json" for (nested) JSON objects and ".po" for gettext catalogs.
+func (this *Locale) LoadFS(fsys fs.FS, name string) error {
+	loader, ok := localeLoaders[strings.ToLower(path.Ext(name))]
+
+	if !ok {
+		return &LocaleLoadError{File: name, Err: errors.New("unsupported locale format, expected .json or .po")}
+	}
+
+	data, err := fs.ReadFile(fsys, name)
+
+	if err != nil {
+		return &LocaleLoadError{File: name, Err: err}
+	}
+
+	return loader(this, name, data)
+}
+
+// LoadFS loads the locales of a directory in the file system. Every locale file is named after its language,
+// i.e. "locales/en.json" and "locales/de.po", or every language has a directory of locale files,
+// i.e. "locales/de/validation.po". Files in other formats are ignored.
+func (this *LocaleBundle) LoadFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+
+	if err != nil {
+		return &LocaleLoadError{File: dir, Err: err}
+	}
+
+	for _, entry := range entries {
+		name := path.Join(dir, entry.Name())
+
+		if entry.IsDir() {
+			if err := this.loadLanguageDir(fsys, name, entry.Name()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !isLocaleFile(name) {
+			continue
+		}
+
+		tag := strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+
+		if err := this.EnsureLocale(tag).LoadFS(fsys, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (this *LocaleBundle) loadLanguageDir(fsys fs.FS, dir string, tag string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+
+	if err != nil {
+		return &LocaleLoadError{File: dir, Err: err}
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !isLocaleFile(entry.Name()) {
+			continue
+		}
+
+		if err := this.EnsureLocale(tag).LoadFS(fsys, path.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lineAt returns the 1-based line number of an offset in the data.
+func lineAt(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// loadJsonLocale loads a JSON object of messages. Nested objects are flattened by joining their keys with dots,
+// so {"min": {"cannotBeShorterThan": "..."}} sets the message "min.cannotBeShorterThan".
+func loadJsonLocale(locale *Locale, file string, data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	messages := map[string]string{}
+
+	fail := func(key string, err error) error {
+		return &LocaleLoadError{File: file, Line: lineAt(data, decoder.InputOffset()), Key: key, Err: err}
+	}
+
+	var readObject func(prefix string) error
+
+	readObject = func(prefix string) error {
+		for decoder.More() {
+			token, err := decoder.Token()
+
+			if err != nil {
+				return fail(strings.TrimSuffix(prefix, "."), err)
+			}
+
+			key := prefix + token.(string)
+			line := lineAt(data, decoder.InputOffset())
+
+			if token, err = decoder.Token(); err != nil {
+				return fail(key, err)
+			}
+
+			switch value := token.(type) {
+			case string:
+				messages[key] = value
+			case json.Delim:
+				if value != '{' {
+					return &LocaleLoadError{File: file, Line: line, Key: key, Err: errors.New("expected a string or an object, but got an array")}
+				}
+				if err := readObject(key + "."); err != nil {
+					return err
+				}
+				if _, err := decoder.Token(); err != nil {
+					return fail(key, err)
+				}
+			default:
+				return &LocaleLoadError{File: file, Line: line, Key: key, Err: fmt.Errorf("expected a string or an object, but got %v", value)}
+			}
+		}
+
+		return nil
+	}
+
+	if token, err := decoder.Token(); err != nil {
+		return fail("", err)
+	} else if token != json.Delim('{') {
+		return fail("", errors.New("expected an object of messages"))
+	}
+
+	if err := readObject(""); err != nil {
+		return err
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return fail("", err)
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return fail("", errors.New("unexpected data after the object of messages"))
+	}
+
+	locale.SetMany(messages)
+
+	return nil
+}
+
+// poEntry is an entry of a gettext catalog that is being parsed.
+type poEntry struct {
+	line        int
+	context     string
+	id          string
+	hasId       bool
+	translation string
+	plural      bool
+	fuzzy       bool
+}
+
+// loadPoLocale loads a gettext catalog. The msgid of every entry is the key of the message and its msgstr the
+// message, prefixed by the msgctxt and a dot if it has one. Untranslated and fuzzy entries are skipped.
+func loadPoLocale(locale *Locale, file string, data []byte) error {
+	messages := map[string]string{}
+	entry := &poEntry{}
+	var field *string
+
+	flush := func() error {
+		defer func() {
+			entry = &poEntry{}
+			field = nil
+		}()
+
+		if !entry.hasId || entry.id == "" {
+			return nil
+		}
+
+		key := entry.id
+
+		if entry.context != "" {
+			key = entry.context + "." + key
+		}
+
+		if entry.plural {
+			return &LocaleLoadError{File: file, Line: entry.line, Key: key, Err: errors.New("plural entries are not supported, use a {name, plural, ...} placeholder in msgstr instead")}
+		}
+
+		if !entry.fuzzy && entry.translation != "" {
+			messages[key] = entry.translation
+		}
+
+		return nil
+	}
+
+	for index, rawLine := range strings.Split(string(data), "\n") {
+		lineNumber := index + 1
+		line := strings.TrimSpace(rawLine)
+
+		if line == "" {
+			if err := flush(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			// Comments precede the entry that they belong to.
+			if entry.hasId {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				entry.fuzzy = true
+			}
+			continue
+		}
+
+		keyword, quoted := line, ""
+
+		if space := strings.IndexAny(line, " \t"); space >= 0 {
+			keyword, quoted = line[:space], strings.TrimSpace(line[space+1:])
+		}
+
+		if strings.HasPrefix(line, "\"") {
+			keyword, quoted = "", line
+		}
+
+		value, err := strconv.Unquote(quoted)
+
+		if err != nil || !strings.HasPrefix(quoted, "\"") {
+			return &LocaleLoadError{File: file, Line: lineNumber, Err: fmt.Errorf("invalid string %s", quoted)}
+		}
+
+		if (keyword == "msgctxt" || keyword == "msgid") && entry.hasId {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case keyword == "":
+			if field == nil {
+				return &LocaleLoadError{File: file, Line: lineNumber, Err: errors.New("string doesn't continue a msgid or msgstr")}
+			}
+			*field += value
+			continue
+		case keyword == "msgctxt":
+			entry.context, field = value, &entry.context
+		case keyword == "msgid":
+			entry.id, field = value, &entry.id
+			entry.hasId = true
+			entry.line = lineNumber
+		case keyword == "msgid_plural":
+			entry.plural, field = true, new(string)
+		case keyword == "msgstr":
+			entry.translation, field = value, &entry.translation
+		case strings.HasPrefix(keyword, "msgstr["):
+			entry.plural = true
+			field = new(string)
+		default:
+			return &LocaleLoadError{File: file, Line: lineNumber, Err: fmt.Errorf("unexpected keyword '%s'", keyword)}
+		}
+
+		if !entry.hasId && keyword != "msgctxt" {
+			return &LocaleLoadError{File: file, Line: lineNumber, Err: fmt.Errorf("%s without msgid", keyword)}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	locale.SetMany(messages)
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package core_test
+
+import (
+	"errors"
+	. "github.com/typerandom/validator/core"
+	"testing"
+	"testing/fstest"
+)
+
+func testThatLocaleHas(t *testing.T, locale *Locale, key string, expected string) {
+	if message, err := locale.Get(key); err != nil || message != expected {
+		t.Fatalf("Expected message '%s' to be '%s', but got '%s' (%v).", key, expected, message, err)
+	}
+}
+
+func testThatLoadFails(t *testing.T, err error, expected string) {
+	var loadErr *LocaleLoadError
+
+	if !errors.As(err, &loadErr) {
+		t.Fatalf("Expected a locale load error, but got '%v'.", err)
+	}
+
+	if err.Error() != expected {
+		t.Fatalf("Expected error '%s', but got '%s'.", expected, err.Error())
+	}
+}
+
+func TestThatNestedJsonLocaleIsFlattened(t *testing.T) {
+	fsys := fstest.MapFS{
+		"en.json": {Data: []byte(`{"min": {"cannotBeShorterThan": "Too short.", "nested": {"key": "Deep."}}, "plain": "Plain."}`)},
+	}
+
+	locale := NewLocale()
+
+	if err := locale.LoadFS(fsys, "en.json"); err != nil {
+		t.Fatalf("Expected no error, but got '%v'.", err)
+	}
+
+	testThatLocaleHas(t, locale, "min.cannotBeShorterThan", "Too short.")
+	testThatLocaleHas(t, locale, "min.nested.key", "Deep.")
+	testThatLocaleHas(t, locale, "plain", "Plain.")
+}
+
+func TestThatInvalidJsonLocaleEntriesArePointedAt(t *testing.T) {
+	fsys := fstest.MapFS{
+		"number.json": {Data: []byte("{\n  \"min\": {\n    \"valid\": \"Valid.\",\n    \"invalid\": 42\n  }\n}")},
+		"array.json":  {Data: []byte("{\n  \"list\": [\"a\"]\n}")},
+		"broken.json": {Data: []byte("{\n  \"key\": \"value\"\n  \"other\": \"value\"\n}")},
+		"other.txt":   {Data: []byte("key=value")},
+	}
+
+	locale := NewLocale()
+
+	testThatLoadFails(t, locale.LoadFS(fsys, "number.json"), "number.json:4: key 'min.invalid': expected a string or an object, but got 42")
+	testThatLoadFails(t, locale.LoadFS(fsys, "array.json"), "array.json:2: key 'list': expected a string or an object, but got an array")
+	testThatLoadFails(t, locale.LoadFS(fsys, "broken.json"), "broken.json:3: invalid character '\"' after object key:value pair")
+	testThatLoadFails(t, locale.LoadFS(fsys, "other.txt"), "other.txt: unsupported locale format, expected .json or .po")
+	testThatLoadFails(t, locale.LoadFS(fsys, "missing.json"), "missing.json: open missing.json: file does not exist")
+
+	if _, err := locale.Get("min.valid"); err == nil {
+		t.Fatal("Expected no messages of an invalid file to be loaded, but they were.")
+	}
+}
+
+func TestThatPoLocaleIsLoaded(t *testing.T) {
+	fsys := fstest.MapFS{
+		"de.po": {Data: []byte(`# German translation.
+msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+
+#: min.go
+msgid "min.cannotBeShorterThan"
+msgstr "{field} darf nicht kürzer "
+"als {min} sein."
+
+msgctxt "fields"
+msgid "User.Name"
+msgstr "Name"
+
+#, fuzzy
+msgid "max.cannotBeLongerThan"
+msgstr "Unsicher."
+
+msgid "untranslated"
+msgstr ""
+msgid "escaped"
+msgstr "Zeile\n\"Zitat\""
+`)},
+	}
+
+	locale := NewLocale()
+
+	if err := locale.LoadFS(fsys, "de.po"); err != nil {
+		t.Fatalf("Expected no error, but got '%v'.", err)
+	}
+
+	testThatLocaleHas(t, locale, "min.cannotBeShorterThan", "{field} darf nicht kürzer als {min} sein.")
+	testThatLocaleHas(t, locale, "fields.User.Name", "Name")
+	testThatLocaleHas(t, locale, "escaped", "Zeile\n\"Zitat\"")
+
+	if keys := locale.Keys(); len(keys) != 3 {
+		t.Fatalf("Expected header, fuzzy and untranslated entries to be skipped, but got %v.", keys)
+	}
+}
+
+func TestThatInvalidPoLocaleEntriesArePointedAt(t *testing.T) {
+	fsys := fstest.MapFS{
+		"plural.po":  {Data: []byte("msgid \"ok\"\nmsgstr \"Ok\"\n\nmsgid \"item\"\nmsgid_plural \"items\"\nmsgstr[0] \"Item\"\nmsgstr[1] \"Items\"\n")},
+		"quote.po":   {Data: []byte("msgid \"ok\"\nmsgstr \"unterminated\n")},
+		"keyword.po": {Data: []byte("msgid \"ok\"\nmsgtext \"Ok\"\n")},
+		"orphan.po":  {Data: []byte("msgstr \"Ok\"\n")},
+	}
+
+	locale := NewLocale()
+
+	testThatLoadFails(t, locale.LoadFS(fsys, "plural.po"), "plural.po:4: key 'item': plural entries are not supported, use a {name, plural, ...} placeholder in msgstr instead")
+	testThatLoadFails(t, locale.LoadFS(fsys, "quote.po"), "quote.po:2: invalid string \"unterminated")
+	testThatLoadFails(t, locale.LoadFS(fsys, "keyword.po"), "keyword.po:2: unexpected keyword 'msgtext'")
+	testThatLoadFails(t, locale.LoadFS(fsys, "orphan.po"), "orphan.po:1: msgstr without msgid")
+}
+
+func TestThatLocaleBundleLoadsDirectoryOfLanguages(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/en.json":          {Data: []byte(`{"greeting": "Hello"}`)},
+		"locales/pt_BR.po":         {Data: []byte("msgid \"greeting\"\nmsgstr \"Olá\"\n")},
+		"locales/de/messages.json": {Data: []byte(`{"greeting": "Hallo"}`)},
+		"locales/de/fields.po":     {Data: []byte("msgid \"fields.Name\"\nmsgstr \"Name\"\n")},
+		"locales/README.md":        {Data: []byte("Locales.")},
+	}
+
+	bundle := NewLocaleBundle("en")
+
+	if err := bundle.LoadFS(fsys, "locales"); err != nil {
+		t.Fatalf("Expected no error, but got '%v'.", err)
+	}
+
+	if tags := bundle.Tags(); len(tags) != 3 || tags[0] != "de" || tags[1] != "en" || tags[2] != "pt-BR" {
+		t.Fatalf("Expected languages [de en pt-BR], but got %v.", tags)
+	}
+
+	testThatLocaleHas(t, bundle.Locale("de"), "greeting", "Hallo")
+	testThatLocaleHas(t, bundle.Locale("de"), "fields.Name", "Name")
+	testThatLocaleHas(t, bundle.Locale("pt-BR"), "greeting", "Olá")
+
+	fsys["locales/fr.json"] = &fstest.MapFile{Data: []byte(`{"greeting": 1}`)}
+
+	testThatLoadFails(t, NewLocaleBundle("en").LoadFS(fsys, "locales"), "locales/fr.json:1: key 'greeting': expected a string or an object, but got 1")
+}
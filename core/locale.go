@@ -1,54 +1,121 @@
 package core
 
 import (
-	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"sort"
+	"sync"
+	"sync/atomic"
 )
 
+// Locale holds the messages of a language. It's safe for concurrent use: messages are read from an immutable
+// snapshot, which is replaced whenever messages are set, so readers never wait on writers.
 type Locale struct {
-	messages map[string]string
+	messages atomic.Value // map[string]string, which is never modified once it's stored
+	lock     sync.Mutex   // serializes writers
 }
 
 func NewLocale() *Locale {
-	return &Locale{
-		messages: make(map[string]string),
-	}
+	locale := &Locale{}
+	locale.messages.Store(map[string]string{})
+	return locale
+}
+
+func (this *Locale) snapshot() map[string]string {
+	messages, _ := this.messages.Load().(map[string]string)
+	return messages
 }
 
 func (this *Locale) Set(key string, value string) {
-	this.messages[key] = value
+	this.SetMany(map[string]string{key: value})
+}
+
+// SetMany sets multiple messages at once. Readers either see all of them or none of them.
+func (this *Locale) SetMany(messages map[string]string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	current := this.snapshot()
+	next := make(map[string]string, len(current)+len(messages))
+
+	for key, value := range current {
+		next[key] = value
+	}
+
+	for key, value := range messages {
+		next[key] = value
+	}
+
+	this.messages.Store(next)
+}
+
+// Replace atomically replaces all messages of the locale. Validations that are in progress keep a consistent view,
+// they either see the old or the new messages.
+func (this *Locale) Replace(messages map[string]string) {
+	next := make(map[string]string, len(messages))
+
+	for key, value := range messages {
+		next[key] = value
+	}
+
+	this.replace(next)
+}
+
+// replace stores a snapshot that must not be modified afterwards.
+func (this *Locale) replace(snapshot map[string]string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.messages.Store(snapshot)
+}
+
+// SetPlural sets a message that selects one of several forms by the plural category of the named parameter.
+// See PluralMessage.
+func (this *Locale) SetPlural(key string, param string, forms map[PluralCategory]string) {
+	this.Set(key, PluralMessage(param, forms))
 }
 
 func (this *Locale) Get(key string) (string, error) {
-	if val, ok := this.messages[key]; ok {
+	if val, ok := this.snapshot()[key]; ok {
 		return val, nil
 	}
 	return "", errors.New("Locale " + key + " does not exist.")
 }
 
-func (this *Locale) LoadJson(filePath string) error {
-	rawJson, err := ioutil.ReadFile(filePath)
+// Lookup retrieves a message and indicates whether or not it exists.
+func (this *Locale) Lookup(key string) (string, bool) {
+	val, ok := this.snapshot()[key]
+	return val, ok
+}
 
-	if err != nil {
-		return err
+// Keys returns the keys of all messages in the locale in alphabetical order.
+func (this *Locale) Keys() []string {
+	messages := this.snapshot()
+	keys := make([]string, 0, len(messages))
+
+	for key := range messages {
+		keys = append(keys, key)
 	}
 
-	var data map[string]string
+	sort.Strings(keys)
 
-	if err = json.Unmarshal(rawJson, &data); err != nil {
-		return err
-	}
+	return keys
+}
 
-	for key, value := range data {
-		this.Set(key, value)
+// LoadJson loads the messages of a JSON file. Nested objects are flattened by joining their keys with dots.
+func (this *Locale) LoadJson(filePath string) error {
+	rawJson, err := ioutil.ReadFile(filePath)
+
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return loadJsonLocale(this, filePath, rawJson)
 }
 
+// Copy copies the locale. Setting messages on the copy doesn't affect the original and vice versa.
 func (this *Locale) Copy() *Locale {
-	return &Locale{
-		messages: this.messages,
-	}
+	locale := &Locale{}
+	locale.messages.Store(this.snapshot())
+	return locale
 }
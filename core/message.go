@@ -0,0 +1,311 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// Params are named values that can be referenced by placeholders in messages, i.e. {min}.
+type Params map[string]interface{}
+
+// MessageData is the data that messages are rendered with.
+//
+// Simple messages reference it through placeholders: {field}, {validator} and {value} refer to the fields with the
+// same names, {0}, {1}... refer to the positional arguments and any other name refers to a named parameter.
+// Plural forms are selected with {name, plural, one {# item} other {# items}}, where the categories follow the
+// CLDR plural rule of the language, =N matches an exact number and # is replaced by the number.
+// Messages that contain "{{" are rendered as a text/template instead, i.e. "{{.Field}} must be at least {{.Params.min}}."
+type MessageData struct {
+	Field     string
+	Validator string
+	Value     interface{}
+	Args      []interface{}
+	Params    Params
+
+	// Language is the tag of the language of the message, which determines the plural rule.
+	Language string
+}
+
+func (this *MessageData) lookup(name string) (interface{}, bool) {
+	switch name {
+	case "field":
+		return this.Field, true
+	case "validator":
+		return this.Validator, true
+	case "value":
+		return this.Value, true
+	}
+
+	if index, err := strconv.Atoi(name); err == nil {
+		if index >= 0 && index < len(this.Args) {
+			return this.Args[index], true
+		}
+		return nil, false
+	}
+
+	value, ok := this.Params[name]
+
+	return value, ok
+}
+
+// Message is an error with a text that is rendered from a locale message when it's displayed.
+type Message struct {
+	// Key is the locale key that the message was retrieved by.
+	Key string
+
+	// Text is the unrendered locale message.
+	Text string
+
+	// Language is the tag of the language that the message was found in.
+	Language string
+
+	Value  interface{}
+	Args   []interface{}
+	Params Params
+
+	// Custom indicates that the text is a custom message of a field, which is never formatted with fmt.Sprintf.
+	Custom bool
+}
+
+// NewMessage creates a message. Arguments of type Params are merged into the named parameters of the message,
+// all other arguments are positional.
+func NewMessage(key string, text string, value interface{}, args ...interface{}) *Message {
+	message := &Message{
+		Key:    key,
+		Text:   text,
+		Value:  value,
+		Params: Params{},
+	}
+
+	for _, arg := range args {
+		if params, ok := arg.(Params); ok {
+			for name, value := range params {
+				message.Params[name] = value
+			}
+		} else {
+			message.Args = append(message.Args, arg)
+		}
+	}
+
+	return message
+}
+
+// Render renders the message for a field and validator.
+func (this *Message) Render(field string, validator string) string {
+	render := RenderMessage
+
+	if this.Custom {
+		render = renderText
+	}
+
+	return render(this.Text, &MessageData{
+		Field:     field,
+		Validator: validator,
+		Value:     this.Value,
+		Args:      this.Args,
+		Params:    this.Params,
+		Language:  this.Language,
+	})
+}
+
+// Error renders the message, leaving the {field} and {validator} placeholders in place.
+func (this *Message) Error() string {
+	return this.Render("{field}", "{validator}")
+}
+
+var (
+	templateCache     = map[string]*template.Template{}
+	templateCacheLock sync.RWMutex
+)
+
+func getTemplate(text string) (*template.Template, error) {
+	templateCacheLock.RLock()
+	cached, ok := templateCache[text]
+	templateCacheLock.RUnlock()
+
+	if ok {
+		return cached, nil
+	}
+
+	parsed, err := template.New("message").Option("missingkey=zero").Parse(text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	templateCacheLock.Lock()
+	templateCache[text] = parsed
+	templateCacheLock.Unlock()
+
+	return parsed, nil
+}
+
+// RenderMessage renders a message with the specified data. See MessageData for the supported syntax.
+// Messages with positional arguments that use format verbs and no placeholders besides {field} and {validator} are
+// formatted with fmt.Sprintf first, for compatibility with messages from before placeholders. Placeholders that can't
+// be resolved are left as they are.
+func RenderMessage(text string, data *MessageData) string {
+	if len(data.Args) > 0 && isFormatText(text) {
+		text = fmt.Sprintf(text, data.Args...)
+	}
+
+	return renderText(text, data)
+}
+
+// isFormatText indicates whether or not a message is written for fmt.Sprintf, i.e. "{field} cannot be %v.". A '%'
+// in a message with other placeholders or a template is taken literally.
+func isFormatText(text string) bool {
+	if !strings.Contains(text, "%") || strings.Contains(text, "{{") {
+		return false
+	}
+
+	for remainder := text; ; {
+		start := strings.IndexByte(remainder, '{')
+
+		if start < 0 {
+			return true
+		}
+
+		end := matchingBrace(remainder, start)
+
+		if end < 0 {
+			return true
+		}
+
+		if name := strings.TrimSpace(remainder[start+1 : end]); name != "field" && name != "validator" {
+			return false
+		}
+
+		remainder = remainder[end+1:]
+	}
+}
+
+func renderText(text string, data *MessageData) string {
+	if strings.Contains(text, "{{") {
+		parsed, err := getTemplate(text)
+
+		if err != nil {
+			// Not a template after all, i.e. a plural form starting with a placeholder: "one {{field} ...}".
+			return renderPlaceholders(text, data)
+		}
+
+		var buffer bytes.Buffer
+
+		if err := parsed.Execute(&buffer, data); err != nil {
+			return text
+		}
+
+		return buffer.String()
+	}
+
+	return renderPlaceholders(text, data)
+}
+
+// matchingBrace returns the index of the brace that closes the one at start, or -1 if it isn't closed.
+func matchingBrace(text string, start int) int {
+	depth := 0
+
+	for i := start; i < len(text); i++ {
+		switch text[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func renderPlaceholders(text string, data *MessageData) string {
+	var buffer bytes.Buffer
+
+	for len(text) > 0 {
+		start := strings.IndexByte(text, '{')
+
+		if start < 0 {
+			break
+		}
+
+		end := matchingBrace(text, start)
+
+		if end < 0 {
+			break
+		}
+
+		buffer.WriteString(text[:start])
+		buffer.WriteString(renderPlaceholder(text[start:end+1], data))
+
+		text = text[end+1:]
+	}
+
+	buffer.WriteString(text)
+
+	return buffer.String()
+}
+
+func renderPlaceholder(placeholder string, data *MessageData) string {
+	inner := placeholder[1 : len(placeholder)-1]
+
+	if parts := strings.SplitN(inner, ",", 3); len(parts) == 3 && strings.TrimSpace(parts[1]) == "plural" {
+		if value, ok := data.lookup(strings.TrimSpace(parts[0])); ok {
+			if rendered, ok := renderPlural(parts[2], value, data); ok {
+				return rendered
+			}
+		}
+		return placeholder
+	}
+
+	if value, ok := data.lookup(strings.TrimSpace(inner)); ok {
+		return fmt.Sprint(value)
+	}
+
+	return placeholder
+}
+
+// renderPlural renders the plural form that matches the value, i.e. of " one {# item} other {# items}".
+func renderPlural(forms string, value interface{}, data *MessageData) (string, bool) {
+	selectors := map[string]string{}
+
+	for forms = strings.TrimSpace(forms); len(forms) > 0; forms = strings.TrimSpace(forms) {
+		start := strings.IndexByte(forms, '{')
+
+		if start <= 0 {
+			return "", false
+		}
+
+		end := matchingBrace(forms, start)
+
+		if end < 0 {
+			return "", false
+		}
+
+		selectors[strings.TrimSpace(forms[:start])] = forms[start+1 : end]
+		forms = forms[end+1:]
+	}
+
+	number := fmt.Sprint(value)
+	form, ok := selectors["="+number]
+
+	if !ok {
+		operands, isNumber := NewPluralOperands(value)
+
+		if !isNumber {
+			return "", false
+		}
+
+		if form, ok = selectors[string(GetPluralRule(data.Language)(operands))]; !ok {
+			if form, ok = selectors[string(PluralOther)]; !ok {
+				return "", false
+			}
+		}
+	}
+
+	return renderPlaceholders(strings.Replace(form, "#", number, -1), data), true
+}
@@ -0,0 +1,108 @@
+package core_test
+
+import (
+	"errors"
+	. "github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"testing"
+)
+
+func testThatMessageRendersAs(t *testing.T, text string, data *MessageData, expected string) {
+	if rendered := RenderMessage(text, data); rendered != expected {
+		t.Fatalf("Expected '%s' to render as '%s', but got '%s'.", text, expected, rendered)
+	}
+}
+
+func TestThatMessageNamedPlaceholdersAreRendered(t *testing.T) {
+	data := &MessageData{
+		Field:     "Name",
+		Validator: "min",
+		Value:     "ab",
+		Args:      []interface{}{"first", 2},
+		Params:    Params{"min": 3},
+	}
+
+	testThatMessageRendersAs(t, "{field} cannot be shorter than {min} characters.", data, "Name cannot be shorter than 3 characters.")
+	testThatMessageRendersAs(t, "{min} characters minimum for { field } ('{value}', {validator}).", data, "3 characters minimum for Name ('ab', min).")
+	testThatMessageRendersAs(t, "{1}, {0}, {2}", data, "2, first, {2}")
+	testThatMessageRendersAs(t, "{unknown} {field", data, "{unknown} {field")
+}
+
+func TestThatMessageTemplatesAreRendered(t *testing.T) {
+	data := &MessageData{
+		Field:  "Name",
+		Value:  "ab",
+		Params: Params{"min": 3},
+	}
+
+	testThatMessageRendersAs(t, "{{.Field}} has {{len .Value}} of {{.Params.min}} characters.", data, "Name has 2 of 3 characters.")
+	testThatMessageRendersAs(t, "{{.Field", data, "{{.Field")
+}
+
+func TestThatMessageWithPositionalArgumentsSupportsFormatVerbs(t *testing.T) {
+	testThatMessageRendersAs(t, "{field} cannot be %v.", &MessageData{Field: "Name", Args: []interface{}{5}}, "Name cannot be 5.")
+}
+
+func TestThatMessageWithPlaceholdersKeepsLiteralPercentSigns(t *testing.T) {
+	data := &MessageData{Field: "Name", Args: []interface{}{"x"}, Params: Params{"max": 5}}
+
+	testThatMessageRendersAs(t, "{field} must be 100% free of {0}.", data, "Name must be 100% free of x.")
+	testThatMessageRendersAs(t, "{field} can be {max}% at most.", data, "Name can be 5% at most.")
+	testThatMessageRendersAs(t, "{{.Field}} must be 100% free.", data, "Name must be 100% free.")
+
+	message := NewMessage("", "{field} must be 100% free of x", nil, "x")
+	message.Custom = true
+
+	if rendered := message.Render("Name", "contain"); rendered != "Name must be 100% free of x" {
+		t.Fatalf("Expected custom message to be rendered literally, but got '%s'.", rendered)
+	}
+}
+
+func TestThatMessageSeparatesNamedAndPositionalArguments(t *testing.T) {
+	message := NewMessage("test.key", "{field} {0} {min} {max} {1}", nil, "a", Params{"min": 1}, "b", Params{"max": 2})
+
+	if message.Error() != "{field} a 1 2 b" {
+		t.Fatalf("Expected '{field} a 1 2 b', but got '%s'.", message)
+	}
+
+	err := NewError(&ReflectedField{Name: "Value"}, &parser.Method{Name: "test"}, message)
+
+	if err.Error() != "Value a 1 2 b" {
+		t.Fatalf("Expected 'Value a 1 2 b', but got '%s'.", err)
+	}
+
+	if err.GetLocaleKey() != "test.key" {
+		t.Fatalf("Expected locale key 'test.key', but got '%s'.", err.GetLocaleKey())
+	}
+
+	if NewError(&ReflectedField{}, &parser.Method{}, errors.New("")).GetLocaleKey() != "" {
+		t.Fatal("Didn't expect locale key for plain error.")
+	}
+}
+
+func TestThatMessagePluralFormsAreSelectedByLanguage(t *testing.T) {
+	text := "{field} needs {min, plural, =0 {nothing} one {# item} few {# itemy} many {# itemów} other {# items}}."
+
+	tests := []struct {
+		language string
+		min      interface{}
+		expected string
+	}{
+		{"en", 1, "Name needs 1 item."},
+		{"en", 2.0, "Name needs 2 items."},
+		{"en", 0, "Name needs nothing."},
+		{"pl", 3, "Name needs 3 itemy."},
+		{"pl", 5, "Name needs 5 itemów."},
+		{"pl", "1.5", "Name needs 1.5 items."},
+	}
+
+	for _, test := range tests {
+		data := &MessageData{Field: "Name", Language: test.language, Params: Params{"min": test.min}}
+		testThatMessageRendersAs(t, text, data, test.expected)
+	}
+
+	testThatMessageRendersAs(t, "{min, plural, one {# {field}} other {# {field}s}}", &MessageData{Field: "x", Params: Params{"min": 2}}, "2 xs")
+	testThatMessageRendersAs(t, "{min, plural, one {# item}}", &MessageData{Params: Params{"min": 2}}, "{min, plural, one {# item}}")
+	testThatMessageRendersAs(t, "{min, plural, other {# items}}", &MessageData{Params: Params{"min": "abc"}}, "{min, plural, other {# items}}")
+	testThatMessageRendersAs(t, "{unknown, plural, other {# items}}", &MessageData{}, "{unknown, plural, other {# items}}")
+}
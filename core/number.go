@@ -0,0 +1,116 @@
+package core
+
+import (
+	"math"
+	"math/big"
+)
+
+// IsNumber indicates whether or not a value is a number, as the parser returns them for numeric literals and as values
+// are normalized to. That's an int64, an uint64, a *big.Int or a float64.
+func IsNumber(value interface{}) bool {
+	switch value.(type) {
+	case int64, uint64, *big.Int, float64:
+		return true
+	}
+	return false
+}
+
+// CompareNumbers compares two numbers exactly, regardless of their types. Returns -1 if a is less than b, 0 if they're
+// equal and 1 if a is greater than b. Returns false if either of them isn't a number or is NaN.
+func CompareNumbers(a interface{}, b interface{}) (int, bool) {
+	// Most comparisons are between numbers of the same type, which don't need arbitrary precision.
+	switch typedA := a.(type) {
+	case int64:
+		if typedB, ok := b.(int64); ok {
+			return compareInts(typedA, typedB), true
+		}
+	case float64:
+		if typedB, ok := b.(float64); ok {
+			if math.IsNaN(typedA) || math.IsNaN(typedB) {
+				return 0, false
+			}
+			return compareFloats(typedA, typedB), true
+		}
+	}
+
+	ratA, ok := numberRat(a)
+
+	if !ok {
+		return 0, false
+	}
+
+	ratB, ok := numberRat(b)
+
+	if !ok {
+		return 0, false
+	}
+
+	if ratA == nil || ratB == nil {
+		// One of them is infinite, which can't be represented exactly, but is less or greater than any finite number.
+		floatA, _ := ToFloat64(a)
+		floatB, _ := ToFloat64(b)
+		return compareFloats(floatA, floatB), true
+	}
+
+	return ratA.Cmp(ratB), true
+}
+
+// numberRat converts a number to a rational number. Returns nil for infinite numbers and false for NaN and
+// values that aren't numbers.
+func numberRat(value interface{}) (*big.Rat, bool) {
+	switch typedValue := value.(type) {
+	case int64:
+		return new(big.Rat).SetInt64(typedValue), true
+	case uint64:
+		return new(big.Rat).SetUint64(typedValue), true
+	case *big.Int:
+		return new(big.Rat).SetInt(typedValue), true
+	case float64:
+		if math.IsNaN(typedValue) {
+			return nil, false
+		}
+		if math.IsInf(typedValue, 0) {
+			return nil, true
+		}
+		return new(big.Rat).SetFloat64(typedValue), true
+	}
+	return nil, false
+}
+
+// ToFloat64 converts a number of any of the types that IsNumber accepts to a float64, i.e. for validators that handled
+// numeric arguments as float64, which all numbers were before integers kept their type. Integers that a float64 can't
+// represent are rounded. Returns false if the value isn't a number.
+func ToFloat64(value interface{}) (float64, bool) {
+	switch typedValue := value.(type) {
+	case int64:
+		return float64(typedValue), true
+	case uint64:
+		return float64(typedValue), true
+	case *big.Int:
+		result, _ := new(big.Float).SetInt(typedValue).Float64()
+		return result, true
+	case float64:
+		return typedValue, true
+	}
+	return 0, false
+}
+
+func compareInts(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,73 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestThatNumbersOfAnyTypeAreComparedExactly(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+
+	tests := []struct {
+		a        interface{}
+		b        interface{}
+		expected int
+	}{
+		{int64(1), int64(2), -1},
+		{int64(2), float64(2), 0},
+		{float64(1.5), int64(1), 1},
+		{int64(math.MaxInt64), uint64(math.MaxInt64 + 1), -1},
+		{int64(math.MaxInt64), float64(math.MaxInt64), -1},
+		{uint64(math.MaxUint64), huge, -1},
+		{huge, float64(1e20), 0},
+		{math.Inf(1), huge, 1},
+		{math.Inf(-1), int64(math.MinInt64), -1},
+	}
+
+	for _, test := range tests {
+		comparison, ok := CompareNumbers(test.a, test.b)
+
+		if !ok || comparison != test.expected {
+			t.Fatalf("Expected %v compared to %v to be %d, but got %d (%t).", test.a, test.b, test.expected, comparison, ok)
+		}
+	}
+}
+
+func TestThatComparingNonNumbersFails(t *testing.T) {
+	for _, value := range []interface{}{"1", nil, true, 1} {
+		if IsNumber(value) {
+			t.Fatalf("Didn't expect %#v to be a number.", value)
+		}
+
+		if _, ok := CompareNumbers(value, int64(1)); ok {
+			t.Fatalf("Expected %#v not to be comparable.", value)
+		}
+	}
+
+	if _, ok := CompareNumbers(math.NaN(), int64(1)); ok {
+		t.Fatal("Expected NaN not to be comparable.")
+	}
+}
+
+func TestThatNumbersOfAnyTypeAreConvertedToFloat64(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected float64
+		ok       bool
+	}{
+		{int64(5), 5, true},
+		{uint64(math.MaxUint64), math.MaxUint64, true},
+		{new(big.Int).Lsh(big.NewInt(1), 70), math.Pow(2, 70), true},
+		{1.5, 1.5, true},
+		{"5", 0, false},
+		{5, 0, false},
+	}
+
+	for _, test := range tests {
+		if value, ok := ToFloat64(test.value); value != test.expected || ok != test.ok {
+			t.Fatalf("Expected %#v to convert to %v (%t), but got %v (%t).", test.value, test.expected, test.ok, value, ok)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// MapKeyLessFn reports whether map key a should be walked before map key b.
+type MapKeyLessFn func(a reflect.Value, b reflect.Value) bool
+
+// SortedMapKeys returns the keys of a map in a deterministic order.
+// Keys of orderable kinds (numbers, strings and booleans) are sorted by value, with NaN after all other numbers.
+// Other keys are sorted using less, or if less is nil, pointers by the values they point to (nil first) and everything
+// else by its formatted representation. Channels, and structs or arrays that contain pointers or channels, are
+// formatted by address, which differs from run to run, so maps with such keys need a less function to be ordered
+// deterministically.
+func SortedMapKeys(mapValue reflect.Value, less MapKeyLessFn) []reflect.Value {
+	keys := mapValue.MapKeys()
+
+	if len(keys) < 2 {
+		return keys
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return isMapKeyLess(keys[i], keys[j], less)
+	})
+
+	return keys
+}
+
+func isMapKeyLess(a reflect.Value, b reflect.Value, less MapKeyLessFn) bool {
+	if a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+
+	if b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+
+	if a.Kind() != b.Kind() {
+		return a.Kind() < b.Kind()
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		// NaN isn't less or greater than any number, so it's put after all of them.
+		if aIsNaN, bIsNaN := math.IsNaN(a.Float()), math.IsNaN(b.Float()); aIsNaN || bIsNaN {
+			return !aIsNaN && bIsNaN
+		}
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	}
+
+	if less != nil {
+		return less(a, b)
+	}
+
+	if a.Kind() == reflect.Ptr {
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() && !b.IsNil()
+		}
+		return isMapKeyLess(a.Elem(), b.Elem(), nil)
+	}
+
+	return fmt.Sprintf("%#v", a.Interface()) < fmt.Sprintf("%#v", b.Interface())
+}
@@ -0,0 +1,73 @@
+package core_test
+
+import (
+	"fmt"
+	. "github.com/typerandom/validator/core"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func testThatMapKeysAreSortedAs(t *testing.T, value interface{}, less MapKeyLessFn, expected string) {
+	for i := 0; i < 10; i++ {
+		var keys []interface{}
+
+		for _, key := range SortedMapKeys(reflect.ValueOf(value), less) {
+			keys = append(keys, key.Interface())
+		}
+
+		if fmt.Sprint(keys) != expected {
+			t.Fatalf("Expected keys to be sorted as '%s', but got '%s'.", expected, fmt.Sprint(keys))
+		}
+	}
+}
+
+func TestThatOrderableMapKeysAreSortedByValue(t *testing.T) {
+	testThatMapKeysAreSortedAs(t, map[string]int{"c": 0, "a": 0, "d": 0, "b": 0}, nil, "[a b c d]")
+	testThatMapKeysAreSortedAs(t, map[int]int{3: 0, -1: 0, 2: 0, 10: 0}, nil, "[-1 2 3 10]")
+	testThatMapKeysAreSortedAs(t, map[uint8]int{3: 0, 1: 0, 2: 0}, nil, "[1 2 3]")
+	testThatMapKeysAreSortedAs(t, map[float64]int{1.5: 0, -2.5: 0, 0: 0}, nil, "[-2.5 0 1.5]")
+	testThatMapKeysAreSortedAs(t, map[bool]int{true: 0, false: 0}, nil, "[false true]")
+	testThatMapKeysAreSortedAs(t, map[interface{}]int{"b": 0, 2: 0, "a": 0, 1: 0}, nil, "[1 2 a b]")
+}
+
+type orderingKeyDummy struct {
+	A int
+	B string
+}
+
+func TestThatUnorderableMapKeysAreSortedByComparator(t *testing.T) {
+	value := map[orderingKeyDummy]int{{1, "z"}: 0, {3, "x"}: 0, {2, "y"}: 0}
+
+	testThatMapKeysAreSortedAs(t, value, nil, "[{1 z} {2 y} {3 x}]")
+
+	testThatMapKeysAreSortedAs(t, value, func(a reflect.Value, b reflect.Value) bool {
+		return a.Interface().(orderingKeyDummy).B < b.Interface().(orderingKeyDummy).B
+	}, "[{3 x} {2 y} {1 z}]")
+}
+
+func TestThatNaNMapKeysAreSortedAfterAllNumbers(t *testing.T) {
+	value := map[float64]int{math.NaN(): 0, 1: 0, math.Inf(1): 0, math.NaN(): 0, -1: 0}
+
+	testThatMapKeysAreSortedAs(t, value, nil, "[-1 1 +Inf NaN NaN]")
+}
+
+func TestThatPointerMapKeysAreSortedByTheirValues(t *testing.T) {
+	value := map[*orderingKeyDummy]int{{3, "x"}: 0, nil: 0, {1, "z"}: 0, {2, "y"}: 0}
+
+	for i := 0; i < 10; i++ {
+		var keys []interface{}
+
+		for _, key := range SortedMapKeys(reflect.ValueOf(value), nil) {
+			if key.IsNil() {
+				keys = append(keys, nil)
+			} else {
+				keys = append(keys, *key.Interface().(*orderingKeyDummy))
+			}
+		}
+
+		if fmt.Sprint(keys) != "[<nil> {1 z} {2 y} {3 x}]" {
+			t.Fatalf("Expected keys to be sorted as '[<nil> {1 z} {2 y} {3 x}]', but got '%s'.", fmt.Sprint(keys))
+		}
+	}
+}
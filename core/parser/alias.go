@@ -0,0 +1,301 @@
+package parser
+
+import (
+	"strings"
+)
+
+// Alias is a named expression that calls of the alias in a tag expand to, i.e. "username" for
+// "min(3),max(32),lowercase". The expression refers to the parameters of the alias as "$<name>".
+type Alias struct {
+	Name       string
+	Params     []string
+	Expression Expression
+}
+
+// ParseAlias parses an alias. The declaration is the name of the alias, optionally followed by the names of its
+// parameters, i.e. "slug(lo, hi)" for the expression "min($lo),max($hi),regexp(´^[a-z0-9-]+$´)".
+func ParseAlias(declaration string, expression string) (*Alias, error) {
+	invalidDeclarationError := newError("alias.invalidDeclaration", "Alias declaration '{declaration}' must be a name, optionally followed by parameter names in parentheses.", map[string]interface{}{
+		"declaration": declaration,
+	}).at(declaration, 0, declaration)
+
+	parsedDeclaration, err := ParseExpression(declaration)
+
+	if err != nil {
+		return nil, invalidDeclarationError
+	}
+
+	method, ok := parsedDeclaration.(*Method)
+
+	if !ok || method.Negated || len(method.Keywords) > 0 {
+		return nil, invalidDeclarationError
+	}
+
+	alias := &Alias{
+		Name: method.Name,
+	}
+
+	for _, arg := range method.Arguments {
+		param, ok := arg.(string)
+
+		if !ok || !isIdentifier(param) {
+			return nil, invalidDeclarationError
+		}
+
+		alias.Params = append(alias.Params, param)
+	}
+
+	if alias.Expression, err = ParseExpression(expression); err != nil {
+		return nil, err
+	}
+
+	if alias.Expression == nil {
+		return nil, newError("parser.unexpectedEnd", "Unexpected end at position {position}.", map[string]interface{}{
+			"position": 0,
+		}).at(expression, 0, "")
+	}
+
+	return alias, nil
+}
+
+// bind maps the parameters of the alias to the positional and keyword arguments of a call of the alias.
+func (this *Alias) bind(call *Method) (map[string]interface{}, error) {
+	count := len(call.Arguments) + len(call.Keywords)
+
+	if count != len(this.Params) {
+		return nil, newError("alias.argumentCount", "Alias '{name}' expects the arguments ({params}), but got {count}.", map[string]interface{}{
+			"name":   this.Name,
+			"params": strings.Join(this.Params, ", "),
+			"count":  count,
+		})
+	}
+
+	values := map[string]interface{}{}
+
+	for i, arg := range call.Arguments {
+		values[this.Params[i]] = arg
+	}
+
+	for _, keyword := range call.Keywords {
+		_, isBound := values[keyword.Name]
+
+		if isBound || !this.hasParam(keyword.Name) {
+			return nil, newError("alias.invalidKeyword", "Alias '{name}' can't bind the keyword argument '{keyword}' to any of its parameters ({params}).", map[string]interface{}{
+				"name":    this.Name,
+				"keyword": keyword.Name,
+				"params":  strings.Join(this.Params, ", "),
+			})
+		}
+
+		values[keyword.Name] = keyword.Value
+	}
+
+	return values, nil
+}
+
+func (this *Alias) hasParam(name string) bool {
+	for _, param := range this.Params {
+		if param == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdentifier indicates whether or not text is a name that can be referenced, i.e. by "$name".
+func isIdentifier(text string) bool {
+	for i, char := range text {
+		if !isAlpha(char) && char != '_' && (i == 0 || !isNumeric(char)) {
+			return false
+		}
+	}
+	return len(text) > 0
+}
+
+// Aliases maps the names of aliases to the aliases.
+type Aliases map[string]*Alias
+
+// Expand replaces the calls of aliases in an expression by the expressions of the aliases, with the arguments of the
+// calls in place of the parameters. Keyword arguments are bound to the parameters of the same name, i.e.
+// "slug(hi=32, lo=3)". Aliases take precedence over validators of the same name. The expression itself is left
+// untouched.
+func (this Aliases) Expand(expression Expression) (Expression, error) {
+	if expression == nil || len(this) == 0 {
+		return expression, nil
+	}
+	return this.expand(expression, nil)
+}
+
+func (this Aliases) expand(expression Expression, stack []string) (Expression, error) {
+	switch typedExpression := expression.(type) {
+	case *Method:
+		alias, ok := this[typedExpression.Name]
+
+		if !ok {
+			return typedExpression, nil
+		}
+
+		for i, name := range stack {
+			if name == alias.Name {
+				return nil, newError("alias.cycle", "Alias '{name}' refers to itself: {cycle}.", map[string]interface{}{
+					"name":  alias.Name,
+					"cycle": strings.Join(append(append([]string{}, stack[i:]...), alias.Name), " -> "),
+				})
+			}
+		}
+
+		values, err := alias.bind(typedExpression)
+
+		if err != nil {
+			return nil, err
+		}
+
+		expanded, err := this.expand(substitute(alias.Expression, values), append(stack, alias.Name))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if typedExpression.Negated {
+			return negateExpression(expanded), nil
+		}
+
+		return expanded, nil
+	case And:
+		operands, err := this.expandOperands(typedExpression, stack)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var and And
+
+		for _, operand := range operands {
+			if nested, ok := operand.(And); ok {
+				and = append(and, nested...)
+			} else {
+				and = append(and, operand)
+			}
+		}
+
+		return and, nil
+	case Or:
+		operands, err := this.expandOperands(typedExpression, stack)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var or Or
+
+		for _, operand := range operands {
+			if nested, ok := operand.(Or); ok {
+				or = append(or, nested...)
+			} else {
+				or = append(or, operand)
+			}
+		}
+
+		return or, nil
+	case *Not:
+		operand, err := this.expand(typedExpression.Operand, stack)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return negateExpression(operand), nil
+	default:
+		return expression, nil
+	}
+}
+
+func (this Aliases) expandOperands(operands []Expression, stack []string) ([]Expression, error) {
+	expanded := make([]Expression, len(operands))
+
+	for i, operand := range operands {
+		var err error
+
+		if expanded[i], err = this.expand(operand, stack); err != nil {
+			return nil, err
+		}
+	}
+
+	return expanded, nil
+}
+
+// negateExpression negates an expression, without stacking negations.
+func negateExpression(expression Expression) Expression {
+	switch typedExpression := expression.(type) {
+	case *Method:
+		negated := *typedExpression
+		negated.Negated = !negated.Negated
+		return &negated
+	case *Not:
+		return typedExpression.Operand
+	default:
+		return &Not{Operand: expression}
+	}
+}
+
+// substitute copies an expression with the references to parameters replaced by the values of the parameters.
+func substitute(expression Expression, values map[string]interface{}) Expression {
+	switch typedExpression := expression.(type) {
+	case *Method:
+		method := *typedExpression
+		method.Arguments = Arguments(substituteArguments(typedExpression.Arguments, values))
+
+		if typedExpression.Keywords != nil {
+			method.Keywords = make(Keywords, len(typedExpression.Keywords))
+
+			for i, keyword := range typedExpression.Keywords {
+				method.Keywords[i] = &Keyword{Name: keyword.Name, Value: substituteArguments([]interface{}{keyword.Value}, values)[0]}
+			}
+		}
+
+		return &method
+	case And:
+		and := make(And, len(typedExpression))
+		for i, operand := range typedExpression {
+			and[i] = substitute(operand, values)
+		}
+		return and
+	case Or:
+		or := make(Or, len(typedExpression))
+		for i, operand := range typedExpression {
+			or[i] = substitute(operand, values)
+		}
+		return or
+	case *Not:
+		return &Not{Operand: substitute(typedExpression.Operand, values)}
+	default:
+		return expression
+	}
+}
+
+func substituteArguments(args []interface{}, values map[string]interface{}) []interface{} {
+	if args == nil {
+		return nil
+	}
+
+	substituted := make([]interface{}, len(args))
+
+	for i, arg := range args {
+		switch typedArg := arg.(type) {
+		case *Reference:
+			if typedArg.Scope == "" && len(typedArg.Path) == 1 {
+				if value, ok := values[typedArg.Path[0]]; ok {
+					substituted[i] = value
+					continue
+				}
+			}
+		case List:
+			substituted[i] = List(substituteArguments(typedArg, values))
+			continue
+		}
+
+		substituted[i] = arg
+	}
+
+	return substituted
+}
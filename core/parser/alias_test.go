@@ -0,0 +1,135 @@
+package parser_test
+
+import (
+	. "github.com/typerandom/validator/core/parser"
+	"testing"
+)
+
+func testThatAliasesExpandAsExpected(t *testing.T, aliases Aliases, test string, expected string) {
+	expression, err := ParseExpression(test)
+
+	if err != nil {
+		t.Fatalf("Tested '%s'. Didn't expect parse error, but got %s.", test, err)
+	}
+
+	expanded, err := aliases.Expand(expression)
+
+	if err != nil {
+		t.Fatalf("Tested '%s'. Didn't expect error, but got %s.", test, err)
+	}
+
+	if call := expanded.Call(); call != expected {
+		t.Fatalf("Tested '%s'. Expected '%s' but got '%s'.", test, expected, call)
+	}
+}
+
+func newTestAliases(t *testing.T, declarations ...string) Aliases {
+	aliases := Aliases{}
+
+	for i := 0; i < len(declarations); i += 2 {
+		alias, err := ParseAlias(declarations[i], declarations[i+1])
+
+		if err != nil {
+			t.Fatalf("Didn't expect error for alias '%s', but got %s.", declarations[i], err)
+		}
+
+		aliases[alias.Name] = alias
+	}
+
+	return aliases
+}
+
+func TestThatAliasesExpandWithArguments(t *testing.T) {
+	aliases := newTestAliases(t,
+		"username", "min(3),max(32),lowercase",
+		"slug(lo, hi)", "min($lo),max($hi),regexp(´^[a-z0-9-]+$´)",
+		"handle(max)", "username | slug(1, $max)",
+		"role", "equal([$Admin, guest])",
+	)
+
+	testThatAliasesExpandAsExpected(t, aliases, "username", "min(3), max(32), lowercase")
+	testThatAliasesExpandAsExpected(t, aliases, "not_empty,username,contain(a)", "not_empty, min(3), max(32), lowercase, contain(´a´)")
+	testThatAliasesExpandAsExpected(t, aliases, "slug(3, $root.Max)", "min(3), max($root.Max), regexp(´^[a-z0-9-]+$´)")
+	testThatAliasesExpandAsExpected(t, aliases, "empty|handle(10)", "empty | min(3), max(32), lowercase | min(1), max(10), regexp(´^[a-z0-9-]+$´)")
+	testThatAliasesExpandAsExpected(t, aliases, "!username", "!(min(3), max(32), lowercase)")
+	testThatAliasesExpandAsExpected(t, aliases, "not_empty,!role", "not_empty, !equal([$Admin, ´guest´])")
+	testThatAliasesExpandAsExpected(t, aliases, "!(username)", "!(min(3), max(32), lowercase)")
+}
+
+func TestThatExpandingAliasesLeavesExpressionUntouched(t *testing.T) {
+	aliases := newTestAliases(t, "slug(lo)", "min($lo)")
+	expression, _ := ParseExpression("slug(3),!slug(4)")
+
+	if _, err := aliases.Expand(expression); err != nil {
+		t.Fatalf("Didn't expect error, but got %s.", err)
+	}
+
+	if call := expression.Call(); call != "slug(3), !slug(4)" {
+		t.Fatalf("Expected expression to be untouched, but got '%s'.", call)
+	}
+
+	if call := aliases["slug"].Expression.Call(); call != "min($lo)" {
+		t.Fatalf("Expected alias to be untouched, but got '%s'.", call)
+	}
+}
+
+func testThatExpandingAliasesFailsWithError(t *testing.T, aliases Aliases, test string, expectedKey string, expectedErr string) {
+	expression, _ := ParseExpression(test)
+
+	_, err := aliases.Expand(expression)
+
+	parseErr, ok := err.(*ParseError)
+
+	if !ok || parseErr.Key != expectedKey || parseErr.Error() != expectedErr {
+		t.Fatalf("Tested '%s'. Expected '%s' error '%s', but got '%v'.", test, expectedKey, expectedErr, err)
+	}
+}
+
+func TestThatExpandingAliasesFailsForCyclesAndWrongArguments(t *testing.T) {
+	aliases := newTestAliases(t,
+		"a", "min(1),b",
+		"b", "empty|c(2)",
+		"c(x)", "max($x),a",
+		"slug(lo, hi)", "min($lo),max($hi)",
+	)
+
+	testThatExpandingAliasesFailsWithError(t, aliases, "not_empty,a", "alias.cycle", "Alias 'a' refers to itself: a -> b -> c -> a.")
+	testThatExpandingAliasesFailsWithError(t, aliases, "c(1)", "alias.cycle", "Alias 'c' refers to itself: c -> a -> b -> c.")
+	testThatExpandingAliasesFailsWithError(t, aliases, "slug(1)", "alias.argumentCount", "Alias 'slug' expects the arguments (lo, hi), but got 1.")
+	testThatExpandingAliasesFailsWithError(t, aliases, "slug(1, hi=2, lo=3)", "alias.argumentCount", "Alias 'slug' expects the arguments (lo, hi), but got 3.")
+	testThatExpandingAliasesFailsWithError(t, aliases, "slug(1, lo=2)", "alias.invalidKeyword", "Alias 'slug' can't bind the keyword argument 'lo' to any of its parameters (lo, hi).")
+	testThatExpandingAliasesFailsWithError(t, aliases, "slug(lo=1, high=2)", "alias.invalidKeyword", "Alias 'slug' can't bind the keyword argument 'high' to any of its parameters (lo, hi).")
+}
+
+func TestThatAliasesBindKeywordArgumentsToParameters(t *testing.T) {
+	aliases := newTestAliases(t,
+		"slug(lo, hi)", "min($lo),max($hi)",
+		"layout(format)", "time(layout=$format)",
+	)
+
+	testThatAliasesExpandAsExpected(t, aliases, "slug(hi=32, lo=3)", "min(3), max(32)")
+	testThatAliasesExpandAsExpected(t, aliases, "slug(3, hi=$root.Max)", "min(3), max($root.Max)")
+	testThatAliasesExpandAsExpected(t, aliases, "layout(´2006´)", "time(layout=´2006´)")
+
+	if _, err := ParseAlias("a(b=1)", "min(1)"); err == nil {
+		t.Fatalf("Expected keyword arguments in declarations to be rejected.")
+	}
+}
+
+func TestThatParsingInvalidAliasesFails(t *testing.T) {
+	for _, declaration := range []string{"", "a|b", "!a", "a(1)", "a(´b c´)", "a(b"} {
+		_, err := ParseAlias(declaration, "min(1)")
+
+		if parseErr, ok := err.(*ParseError); !ok || parseErr.Key != "alias.invalidDeclaration" {
+			t.Fatalf("Tested '%s'. Expected invalid declaration error, but got '%v'.", declaration, err)
+		}
+	}
+
+	if _, err := ParseAlias("a", "min(1"); err == nil {
+		t.Fatalf("Expected parse error of the expression.")
+	}
+
+	if _, err := ParseAlias("a", ""); err == nil {
+		t.Fatalf("Expected error for an empty expression.")
+	}
+}
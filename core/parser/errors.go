@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseError is an error in the syntax of a tag. The key identifies the kind of error, so that it can be translated and
+// used as a stable error code. The text is the message of the error, where {name} placeholders refer to the params.
+type ParseError struct {
+	Key    string
+	Text   string
+	Params map[string]interface{}
+
+	// Tag is the full text that was parsed, empty if the error isn't located in a text.
+	Tag string
+
+	// Offset is the byte offset of the offending token in the tag.
+	Offset int
+
+	// Token is the text of the offending token, empty if the tag ended unexpectedly.
+	Token string
+}
+
+func newError(key string, text string, params map[string]interface{}) *ParseError {
+	return &ParseError{
+		Key:    key,
+		Text:   text,
+		Params: params,
+	}
+}
+
+// at locates the error at the offending token of the tag and returns the error.
+func (this *ParseError) at(tag string, offset int, token string) *ParseError {
+	this.Tag = tag
+	this.Offset = offset
+	this.Token = token
+	return this
+}
+
+func (this *ParseError) Error() string {
+	message := this.Text
+
+	for name, value := range this.Params {
+		message = strings.Replace(message, "{"+name+"}", fmt.Sprint(value), -1)
+	}
+
+	return message
+}
+
+// Snippet renders the tag with a caret below the offending token, i.e.
+//
+//	min(1,,2)
+//	      ^
+//
+// Returns an empty string if the error isn't located in a tag.
+func (this *ParseError) Snippet() string {
+	if this.Tag == "" || this.Offset < 0 || this.Offset > len(this.Tag) {
+		return ""
+	}
+
+	// Tabs are kept, so that the caret lines up with the tag whatever the width of a tab.
+	indent := strings.Map(func(char rune) rune {
+		if char == '\t' {
+			return char
+		}
+		return ' '
+	}, this.Tag[:this.Offset])
+
+	caret := "^"
+
+	if length := utf8.RuneCountInString(this.Token); length > 1 {
+		caret += strings.Repeat("~", length-1)
+	}
+
+	return this.Tag + "\n" + indent + caret
+}
@@ -0,0 +1,137 @@
+package parser
+
+import (
+	"strings"
+)
+
+// Expression is a node of a parsed tag. It's either a *Method, or a combination of other expressions: And, Or and
+// Not. Operands of the same kind are merged, so an And never directly contains another And.
+type Expression interface {
+	// Call returns the expression as it's written in a tag, i.e. "empty | numeric, min(5)".
+	Call() string
+
+	// Methods returns all methods of the expression, in the order that they're written.
+	Methods() Methods
+}
+
+// And is satisfied if all of its operands are satisfied. Operands are separated by ',' in a tag.
+type And []Expression
+
+// Or is satisfied if any of its operands is satisfied. Operands are separated by '|' in a tag.
+type Or []Expression
+
+// Not is satisfied if its operand isn't. It's written as '!' followed by a parenthesized expression in a tag,
+// a negated method is a *Method with Negated set instead.
+type Not struct {
+	Operand Expression
+}
+
+func (this *Method) Methods() Methods {
+	return Methods{this}
+}
+
+func (this And) Methods() Methods {
+	return collectMethods(this)
+}
+
+func (this Or) Methods() Methods {
+	return collectMethods(this)
+}
+
+func (this *Not) Methods() Methods {
+	return this.Operand.Methods()
+}
+
+func collectMethods(operands []Expression) Methods {
+	var methods Methods
+
+	for _, operand := range operands {
+		methods = append(methods, operand.Methods()...)
+	}
+
+	return methods
+}
+
+func (this And) Call() string {
+	calls := make([]string, len(this))
+
+	for i, operand := range this {
+		// Or binds weaker than And, so it needs parentheses inside of it.
+		if _, ok := operand.(Or); ok {
+			calls[i] = "(" + operand.Call() + ")"
+		} else {
+			calls[i] = callOf(operand)
+		}
+	}
+
+	return strings.Join(calls, ", ")
+}
+
+func (this Or) Call() string {
+	calls := make([]string, len(this))
+
+	for i, operand := range this {
+		calls[i] = callOf(operand)
+	}
+
+	return strings.Join(calls, " | ")
+}
+
+func (this *Not) Call() string {
+	return "!(" + this.Operand.Call() + ")"
+}
+
+// callOf returns the call of an operand, including the negation of a method, which Method.Call leaves out.
+func callOf(operand Expression) string {
+	if method, ok := operand.(*Method); ok && method.Negated {
+		return "!" + method.Call()
+	}
+	return operand.Call()
+}
+
+func (this And) String() string {
+	return "and(" + joinOperands(this) + ")"
+}
+
+func (this Or) String() string {
+	return "or(" + joinOperands(this) + ")"
+}
+
+func (this *Not) String() string {
+	return "not(" + joinOperands([]Expression{this.Operand}) + ")"
+}
+
+func joinOperands(operands []Expression) string {
+	result := ""
+
+	for _, operand := range operands {
+		if result != "" {
+			result += ", "
+		}
+		if stringer, ok := operand.(interface{ String() string }); ok {
+			result += stringer.String()
+		}
+	}
+
+	return result
+}
+
+// Groups returns the methods of every top level alternative of an expression, which is the method groups that Parse
+// returns. Alternatives with nested expressions contain all of their methods.
+func Groups(expression Expression) []Methods {
+	if expression == nil {
+		return []Methods{nil}
+	}
+
+	if or, ok := expression.(Or); ok {
+		groups := make([]Methods, len(or))
+
+		for i, operand := range or {
+			groups[i] = operand.Methods()
+		}
+
+		return groups
+	}
+
+	return []Methods{expression.Methods()}
+}
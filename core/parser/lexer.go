@@ -2,6 +2,8 @@ package parser
 
 import (
 	"bytes"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -27,6 +29,17 @@ func isNumeric(char rune) bool {
 	return char >= '0' && char <= '9'
 }
 
+func isDigitOfBase(char rune, base int) bool {
+	switch base {
+	case 2:
+		return char == '0' || char == '1'
+	case 16:
+		return isNumeric(char) || (char >= 'a' && char <= 'f') || (char >= 'A' && char <= 'F')
+	default:
+		return isNumeric(char)
+	}
+}
+
 func isAlphaNumeric(char rune) bool {
 	return isAlpha(char) || isNumeric(char)
 }
@@ -97,7 +110,81 @@ TEXT_SCAN:
 	scanner.next()
 	scanner.skip()
 
-	return lexArgs
+	return afterValue(scanner)
+}
+
+// lexArgValueSingleQuotedText lexes a string in single quotes after its opening quote. \' and \\ are escapes, other
+// backslashes are kept as they are, so that patterns like '^\d+$' can be written without doubling them.
+func lexArgValueSingleQuotedText(scanner *scanner) lexer {
+	var buffer bytes.Buffer
+
+	for {
+		switch char := scanner.next(); char {
+		case '\\':
+			if next := scanner.peek(); next == '\'' || next == '\\' {
+				scanner.next()
+				buffer.WriteRune(next)
+			} else {
+				buffer.WriteRune(char)
+			}
+		case '\'':
+			scanner.backup()
+			scanner.emitValue(TOKEN_ARG_STRING, buffer.String())
+			scanner.next()
+			scanner.skip()
+			return afterValue(scanner)
+		case eof:
+			return scanner.UnexpectedEndError()
+		default:
+			buffer.WriteRune(char)
+		}
+	}
+}
+
+// lexArgValueInterpretedText lexes a string in double quotes, including its opening quote. The string has the escapes
+// of an interpreted string literal in Go, i.e. "a\tb" or "\u00e9".
+func lexArgValueInterpretedText(scanner *scanner) lexer {
+TEXT_SCAN:
+	for {
+		switch scanner.next() {
+		case '\\':
+			scanner.next()
+		case '"':
+			break TEXT_SCAN
+		case eof:
+			return scanner.UnexpectedEndError()
+		}
+	}
+
+	value, err := strconv.Unquote(scanner.text())
+
+	if err != nil {
+		return scanner.error(newError("parser.invalidString", "Invalid string {string} at position {position}.", map[string]interface{}{
+			"string":   scanner.text(),
+			"position": scanner.start + 1,
+		}).at(scanner.value, scanner.start, scanner.text()))
+	}
+
+	scanner.emitValue(TOKEN_ARG_STRING, value)
+
+	return afterValue(scanner)
+}
+
+// lexArgValueRawText lexes a string in back quotes after its opening quote. Like a raw string literal in Go, it has no
+// escapes and can't contain back quotes.
+func lexArgValueRawText(scanner *scanner) lexer {
+	for {
+		switch scanner.next() {
+		case '`':
+			scanner.backup()
+			scanner.emit(TOKEN_ARG_STRING)
+			scanner.next()
+			scanner.skip()
+			return afterValue(scanner)
+		case eof:
+			return scanner.UnexpectedEndError()
+		}
+	}
 }
 
 func lexArgValueUnboundedText(scanner *scanner) lexer {
@@ -106,7 +193,7 @@ TEXT_SCAN:
 		switch char := scanner.next(); {
 		case isAlphaNumeric(char) || char == '_':
 			continue
-		case char == ',' || char == ')' || isWhiteSpace(char):
+		case char == ',' || char == ')' || char == ']' || char == '=' || isWhiteSpace(char):
 			scanner.backup()
 			break TEXT_SCAN
 		case char == eof:
@@ -116,6 +203,13 @@ TEXT_SCAN:
 		}
 	}
 
+	// A name that's followed by '=' is the keyword of a keyword argument, i.e. "max" in "len(max=16)". Lists can't
+	// contain keyword arguments.
+	if !scanner.inList && strings.HasPrefix(strings.TrimLeft(scanner.value[scanner.position:], " \t"), "=") {
+		scanner.emit(TOKEN_ARG_KEYWORD)
+		return lexKeywordAssignment
+	}
+
 	switch scanner.text() {
 	case "true", "false":
 		scanner.emit(TOKEN_ARG_BOOLEAN)
@@ -125,35 +219,112 @@ TEXT_SCAN:
 		scanner.emit(TOKEN_ARG_STRING)
 	}
 
-	return lexArgs
+	return afterValue(scanner)
+}
+
+// lexKeywordAssignment lexes the '=' between the keyword and the value of a keyword argument.
+func lexKeywordAssignment(scanner *scanner) lexer {
+	switch char := scanner.next(); {
+	case char == '=':
+		scanner.skip()
+		return lexArgValue
+	case isWhiteSpace(char):
+		return lexWhiteSpace(scanner, lexKeywordAssignment)
+	default:
+		return scanner.unexpectedCharError()
+	}
 }
 
+// lexArgValueReference lexes the path of a reference after its '$', i.e. "root.Limits.MaxItems".
+func lexArgValueReference(scanner *scanner) lexer {
+	isSegmentStart := true
+
+REFERENCE_SCAN:
+	for {
+		switch char := scanner.next(); {
+		case isAlpha(char) || char == '_' || (isNumeric(char) && !isSegmentStart):
+			isSegmentStart = false
+		case char == '.' && !isSegmentStart:
+			isSegmentStart = true
+		case (char == ',' || char == ')' || char == ']' || isWhiteSpace(char)) && !isSegmentStart:
+			scanner.backup()
+			break REFERENCE_SCAN
+		case char == eof:
+			return scanner.UnexpectedEndError()
+		default:
+			return scanner.unexpectedCharError()
+		}
+	}
+
+	scanner.emit(TOKEN_ARG_REFERENCE)
+
+	return afterValue(scanner)
+}
+
+// lexArgValueNumber lexes a number with an optional sign. Integers are decimal, hexadecimal ("0x1F") or binary
+// ("0b101"), floats are decimal with a fraction, an exponent or both ("1.5", "2e-3"). Digits can be separated by
+// underscores, i.e. "1_000_000". A leading zero doesn't make a number octal.
 func lexArgValueNumber(scanner *scanner) lexer {
 	var returnTo lexer
-	isFloat := false
+	base := 10
+	isFloat, hasExponent := false, false
+
+	// The number of digits after the prefix or the exponent, and the last character, which an underscore must be
+	// between digits and the number can't end with.
+	digits := 0
+	var previous rune = eof
+
+	if char := scanner.peek(); char == '+' || char == '-' {
+		scanner.next()
+	}
+
+	if scanner.peek() == '0' {
+		previous = scanner.next()
+		digits++
+
+		switch scanner.peek() {
+		case 'x', 'X':
+			base = 16
+		case 'b', 'B':
+			base = 2
+		}
+
+		if base != 10 {
+			previous = scanner.next()
+			digits = 0
+		}
+	}
 
 NUMBER_SCAN:
 	for {
-		switch char := scanner.next(); {
-		case char == '+' || char == '-':
-			if scanner.length() != 1 {
-				return scanner.unexpectedCharError()
+		char := scanner.next()
+
+		switch {
+		case isDigitOfBase(char, base):
+			digits++
+		case char == '_' && isDigitOfBase(previous, base):
+		case char == '.' && base == 10 && !isFloat && previous != '_':
+			isFloat = true
+		case (char == 'e' || char == 'E') && base == 10 && !hasExponent && digits > 0 && previous != '_':
+			isFloat, hasExponent = true, true
+			digits = 0
+
+			if sign := scanner.peek(); sign == '+' || sign == '-' {
+				char = scanner.next()
 			}
-		case isNumeric(char):
+
+			previous = char
 			continue
-		case char == '.':
-			if scanner.length() == 1 || isFloat {
-				return scanner.unexpectedCharError()
-			}
-			isFloat = true
-		case char == ',' || char == ')' || isWhiteSpace(char):
-			returnTo = lexArgs
+		case (char == ',' || char == ')' || char == ']' || isWhiteSpace(char)) && digits > 0 && previous != '_':
+			returnTo = afterValue(scanner)
 			break NUMBER_SCAN
 		case char == eof:
 			return scanner.UnexpectedEndError()
 		default:
 			return scanner.unexpectedCharError()
 		}
+
+		previous = char
 	}
 
 	scanner.backup()
@@ -178,6 +349,19 @@ func lexArgValue(scanner *scanner) lexer {
 	case char == '´':
 		scanner.skip()
 		return lexArgValueBoundedText
+	case char == '\'':
+		scanner.skip()
+		return lexArgValueSingleQuotedText
+	case char == '"':
+		return lexArgValueInterpretedText
+	case char == '`':
+		scanner.skip()
+		return lexArgValueRawText
+	case char == '[' && !scanner.inList:
+		return lexListStart
+	case char == '$':
+		scanner.skip()
+		return lexArgValueReference
 	case isWhiteSpace(char):
 		return lexWhiteSpace(scanner, lexArgValue)
 	default:
@@ -185,6 +369,51 @@ func lexArgValue(scanner *scanner) lexer {
 	}
 }
 
+// afterValue returns the lexer for what follows an argument value, which depends on whether or not it's in a list.
+func afterValue(scanner *scanner) lexer {
+	if scanner.inList {
+		return lexListItems
+	}
+	return lexArgs
+}
+
+// lexListStart lexes the '[' that opens a list argument, i.e. "[a, 1, true]". Lists can't be nested.
+func lexListStart(scanner *scanner) lexer {
+	scanner.emit(TOKEN_LIST_START)
+	scanner.inList = true
+
+	for isWhiteSpace(scanner.peek()) {
+		scanner.next()
+	}
+
+	scanner.skip()
+
+	if scanner.peek() == ']' {
+		return lexListItems
+	}
+
+	return lexArgValue
+}
+
+// lexListItems lexes what follows a value in a list: ',' and another value, or the ']' that closes the list.
+func lexListItems(scanner *scanner) lexer {
+	switch char := scanner.next(); {
+	case char == ',':
+		scanner.skip()
+		return lexArgValue
+	case char == ']':
+		scanner.emit(TOKEN_LIST_END)
+		scanner.inList = false
+		return lexArgs
+	case isWhiteSpace(char):
+		return lexWhiteSpace(scanner, lexListItems)
+	case char == eof:
+		return scanner.UnexpectedEndError()
+	default:
+		return scanner.unexpectedCharError()
+	}
+}
+
 func lexArgs(scanner *scanner) lexer {
 	switch char := scanner.next(); {
 	case char == ',':
@@ -196,14 +425,14 @@ func lexArgs(scanner *scanner) lexer {
 		if scanner.peek() == ')' {
 			scanner.next()
 			scanner.skip()
-			returnTo = lexMethod
+			returnTo = lexOperator
 		}
 
 		scanner.skip()
 		return returnTo
 	case char == ')':
 		scanner.skip()
-		return lexMethod
+		return lexOperator
 	case isWhiteSpace(char):
 		return lexWhiteSpace(scanner, lexArgs)
 	default:
@@ -212,23 +441,17 @@ func lexArgs(scanner *scanner) lexer {
 }
 
 func lexMethodName(scanner *scanner) lexer {
-	var returnTo lexer
+	var returnTo lexer = lexOperator
 
 NAME_SCAN:
 	for {
 		switch char := scanner.next(); {
 		case isAlphaNumeric(char) || char == '_':
 			continue
-		case char == '|':
-			returnTo = lexGroup
-			break NAME_SCAN
-		case char == ',':
-			returnTo = lexMethod
-			break NAME_SCAN
 		case char == '(':
 			returnTo = lexArgs
 			break NAME_SCAN
-		case char == eof:
+		case char == ',' || char == '|' || char == ')' || isWhiteSpace(char) || char == eof:
 			break NAME_SCAN
 		default:
 			return scanner.unexpectedCharError()
@@ -241,42 +464,81 @@ NAME_SCAN:
 	return returnTo
 }
 
-func lexMethod(scanner *scanner) lexer {
+// lexNegation lexes the '!' that negates the method or the parenthesized expression that follows it.
+func lexNegation(scanner *scanner) lexer {
+	scanner.emit(TOKEN_NOT)
+
 	switch char := scanner.next(); {
-	case isAlphaNumeric(char) || char == '_':
+	case isAlpha(char):
 		scanner.backup()
 		return lexMethodName
-	case char == '|':
-		scanner.backup()
-		return lexGroup
-	case char == ',':
-		if scanner.peek() == eof {
-			return scanner.unexpectedCharError()
-		}
-		scanner.skip()
-		return lexMethod
 	case char == '(':
-		scanner.skip()
-		return lexArgs
+		return lexOpen
 	case char == eof:
-		return nil
+		return scanner.UnexpectedEndError()
 	default:
 		return scanner.unexpectedCharError()
 	}
 }
 
-func lexGroup(scanner *scanner) lexer {
+// lexOpen lexes the '(' that opens a nested expression.
+func lexOpen(scanner *scanner) lexer {
+	if scanner.peek() == eof {
+		return scanner.unexpectedCharError()
+	}
+
+	scanner.depth++
+	scanner.emit(TOKEN_OPEN)
+
+	return lexTerm
+}
+
+// lexTerm lexes the start of an operand, which is a method, a negation or a parenthesized expression.
+func lexTerm(scanner *scanner) lexer {
 	switch char := scanner.next(); {
 	case isAlpha(char):
 		scanner.backup()
-		return lexMethod
-	case char == '|':
-		next := scanner.peek()
-		if scanner.position == 1 || next == '|' || next == eof {
+		return lexMethodName
+	case char == '!':
+		return lexNegation
+	case char == '(':
+		return lexOpen
+	case isWhiteSpace(char):
+		return lexWhiteSpace(scanner, lexTerm)
+	case char == eof:
+		return scanner.UnexpectedEndError()
+	default:
+		return scanner.unexpectedCharError()
+	}
+}
+
+// lexOperator lexes what follows an operand: ',' (and), '|' (or), the ')' that closes a nested expression, or the end.
+func lexOperator(scanner *scanner) lexer {
+	switch char := scanner.next(); {
+	case char == ',' || char == '|':
+		if next := scanner.peek(); next == eof || next == ',' || next == '|' {
 			return scanner.unexpectedCharError()
 		}
-		scanner.emit(TOKEN_GROUP)
-		return lexMethod
+		if char == ',' {
+			scanner.emit(TOKEN_AND)
+		} else {
+			scanner.emit(TOKEN_GROUP)
+		}
+		return lexTerm
+	case char == ')':
+		if scanner.depth == 0 {
+			return scanner.unexpectedCharError()
+		}
+		scanner.depth--
+		scanner.emit(TOKEN_CLOSE)
+		return lexOperator
+	case isWhiteSpace(char):
+		return lexWhiteSpace(scanner, lexOperator)
+	case char == eof:
+		if scanner.depth > 0 {
+			return scanner.UnexpectedEndError()
+		}
+		return nil
 	default:
 		return scanner.unexpectedCharError()
 	}
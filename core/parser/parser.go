@@ -1,9 +1,10 @@
 package parser
 
 import (
-	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
 type Methods []*Method
@@ -23,6 +24,86 @@ func (this Methods) String() string {
 
 type Arguments []interface{}
 
+// Reference is an argument that refers to a value which is resolved when the field is validated, written as "$" and
+// a path in a tag. Paths start at the struct that the field belongs to, i.e. "$Password", at the value that is
+// validated if the first name is "root", i.e. "$root.Limits.MaxItems", or at the values of the call to Validate if the
+// first name is "ctx", i.e. "$ctx.tenantMax".
+type Reference struct {
+	// Scope is ReferenceRoot, ReferenceContext, or empty for the struct of the field.
+	Scope string
+
+	// Path contains the names of the fields, or keys of maps, that lead to the value within the scope.
+	Path []string
+}
+
+const (
+	ReferenceRoot    = "root"
+	ReferenceContext = "ctx"
+)
+
+// NewReference creates a reference from its path as it's written in a tag, without the "$".
+func NewReference(path string) *Reference {
+	names := strings.Split(path, ".")
+
+	if names[0] == ReferenceRoot || names[0] == ReferenceContext {
+		return &Reference{Scope: names[0], Path: names[1:]}
+	}
+
+	return &Reference{Path: names}
+}
+
+func (this *Reference) String() string {
+	if this.Scope != "" {
+		return "$" + strings.Join(append([]string{this.Scope}, this.Path...), ".")
+	}
+	return "$" + strings.Join(this.Path, ".")
+}
+
+// References returns the references of the arguments, including those in list arguments.
+func (args Arguments) References() []*Reference {
+	var references []*Reference
+
+	for _, arg := range args {
+		switch typedArg := arg.(type) {
+		case *Reference:
+			references = append(references, typedArg)
+		case List:
+			references = append(references, Arguments(typedArg).References()...)
+		}
+	}
+
+	return references
+}
+
+// HasReferences indicates whether or not any of the arguments, or a value of a list argument, is a reference.
+func (args Arguments) HasReferences() bool {
+	return len(args.References()) > 0
+}
+
+// List is an argument that holds a list of values, written as "[a, 1, true]" in a tag. Its values are numbers
+// (int64, uint64, *big.Int or float64), strings, booleans, nil and references, lists can't be nested.
+type List []interface{}
+
+func (this List) String() string {
+	result := ""
+
+	for _, val := range this {
+		if result != "" {
+			result += ", "
+		}
+		result += formatArgument(val)
+	}
+
+	return "[" + result + "]"
+}
+
+func formatArgument(val interface{}) string {
+	if _, ok := val.(string); ok {
+		return fmt.Sprintf("'%v'", val)
+	}
+	return fmt.Sprintf("%v", val)
+}
+
 func (args Arguments) String() string {
 	if len(args) == 0 {
 		return "(none)"
@@ -34,78 +115,469 @@ func (args Arguments) String() string {
 		if result != "" {
 			result += ", "
 		}
-		if _, ok := val.(string); ok {
-			result += fmt.Sprintf("'%v'", val)
-		} else {
-			result += fmt.Sprintf("%v", val)
-		}
+		result += formatArgument(val)
 	}
 
 	return result
 }
 
+// Keyword is a keyword argument, written as "name=value" in a tag after the positional arguments, i.e. "max=16".
+type Keyword struct {
+	Name  string
+	Value interface{}
+}
+
+func (this *Keyword) String() string {
+	return this.Name + "=" + formatArgument(this.Value)
+}
+
+// Keywords are the keyword arguments of a method, in the order that they're written.
+type Keywords []*Keyword
+
+// Get returns the value of the keyword argument with the specified name.
+func (this Keywords) Get(name string) (interface{}, bool) {
+	for _, keyword := range this {
+		if keyword.Name == name {
+			return keyword.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Values returns the values of the keyword arguments, in the order that they're written.
+func (this Keywords) Values() []interface{} {
+	values := make([]interface{}, len(this))
+
+	for i, keyword := range this {
+		values[i] = keyword.Value
+	}
+
+	return values
+}
+
+func (this Keywords) String() string {
+	keywords := make([]string, len(this))
+
+	for i, keyword := range this {
+		keywords[i] = keyword.String()
+	}
+
+	return strings.Join(keywords, ", ")
+}
+
 type Method struct {
 	Name      string
 	Arguments Arguments
+
+	// Keywords are the keyword arguments of the method, which are written after the positional Arguments.
+	Keywords Keywords
+
+	// Negated indicates whether or not the method is prefixed with '!', which inverts its result.
+	Negated bool
 }
 
 func (this *Method) String() string {
-	return "{ name: '" + this.Name + "', args: " + this.Arguments.String() + " }"
+	result := "{ name: '" + this.Name + "', args: " + this.Arguments.String()
+
+	if len(this.Keywords) > 0 {
+		result += ", keywords: " + this.Keywords.String()
+	}
+
+	if this.Negated {
+		result += ", negated: true"
+	}
+
+	return result + " }"
+}
+
+// References returns the references of the positional and keyword arguments of the method.
+func (this *Method) References() []*Reference {
+	return append(this.Arguments.References(), Arguments(this.Keywords.Values()).References()...)
 }
 
+// HasReferences indicates whether or not any of the positional or keyword arguments of the method is a reference.
+func (this *Method) HasReferences() bool {
+	return len(this.References()) > 0
+}
+
+// Call returns the method as it's written in a tag, without its negation. I.e. "contain(´admin´)".
+func (this *Method) Call() string {
+	if len(this.Arguments) == 0 && len(this.Keywords) == 0 {
+		return this.Name
+	}
+
+	args := make([]string, 0, len(this.Arguments)+len(this.Keywords))
+
+	for _, arg := range this.Arguments {
+		args = append(args, callArgument(arg))
+	}
+
+	for _, keyword := range this.Keywords {
+		args = append(args, keyword.Name+"="+callArgument(keyword.Value))
+	}
+
+	return this.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// callArgument returns an argument as it's written in a tag.
+func callArgument(arg interface{}) string {
+	switch typedArg := arg.(type) {
+	case string:
+		return "´" + strings.Replace(strings.Replace(typedArg, "\\", "\\\\", -1), "´", "\\´", -1) + "´"
+	case float64:
+		return formatFloat(typedArg)
+	case nil:
+		return "nil"
+	case *Reference:
+		return typedArg.String()
+	case List:
+		values := make([]string, len(typedArg))
+
+		for i, value := range typedArg {
+			values[i] = callArgument(value)
+		}
+
+		return "[" + strings.Join(values, ", ") + "]"
+	default:
+		return fmt.Sprint(typedArg)
+	}
+}
+
+// formatFloat formats a float so that it's parsed as a float again, i.e. "1.0" instead of "1".
+func formatFloat(value float64) string {
+	text := strconv.FormatFloat(value, 'g', -1, 64)
+
+	if !strings.ContainsAny(text, ".eIN") {
+		text += ".0"
+	}
+
+	return text
+}
+
+// Parse parses a tag into method groups, which are the top level alternatives of the expression in the tag. Use
+// ParseExpression to keep the structure of nested expressions.
 func Parse(text string) ([]Methods, error) {
+	expression, err := ParseExpression(text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Groups(expression), nil
+}
+
+// ParseExpression parses a tag into an expression. Returns nil for an empty tag.
+func ParseExpression(text string) (Expression, error) {
 	scanner := &scanner{
 		value: text,
 	}
 
-	if len(scanner.value) > 0 {
-		for lexer := lexGroup; lexer != nil; {
-			lexer = lexer(scanner)
+	if len(scanner.value) == 0 {
+		return nil, nil
+	}
+
+	for lexer := lexTerm; lexer != nil; {
+		lexer = lexer(scanner)
+	}
+
+	// The lexer stops at the first error, so the error is the last token.
+	if last := scanner.tokens[len(scanner.tokens)-1]; last.type_ == TOKEN_ERROR {
+		return nil, last.err
+	}
+
+	parser := &parser{text: text, tokens: scanner.tokens}
+
+	expression, err := parser.parseOr()
+
+	if err == nil && parser.position < len(parser.tokens) {
+		err = parser.unhandledTokenError()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return expression, nil
+}
+
+// parser builds an expression from the tokens of a tag, which the lexer has already checked for syntax errors.
+type parser struct {
+	text     string
+	tokens   []*token
+	position int
+}
+
+func (this *parser) peek() tokenType {
+	if this.position >= len(this.tokens) {
+		return TOKEN_EOF
+	}
+	return this.tokens[this.position].type_
+}
+
+func (this *parser) next() *token {
+	token := this.tokens[this.position]
+	this.position++
+	return token
+}
+
+func (this *parser) unhandledTokenError() error {
+	err := newError("parser.unhandledToken", "Unable to parse. Unhandled token type.", nil)
+
+	if this.position < len(this.tokens) {
+		token := this.tokens[this.position]
+		return err.at(this.text, token.position, token.value)
+	}
+
+	return err.at(this.text, len(this.text), "")
+}
+
+func (this *parser) parseOr() (Expression, error) {
+	var operands Or
+
+	for {
+		operand, err := this.parseAnd()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if nested, ok := operand.(Or); ok {
+			operands = append(operands, nested...)
+		} else {
+			operands = append(operands, operand)
+		}
+
+		if this.peek() != TOKEN_GROUP {
+			break
+		}
+
+		this.next()
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+
+	return operands, nil
+}
+
+func (this *parser) parseAnd() (Expression, error) {
+	var operands And
+
+	for {
+		operand, err := this.parseUnary()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if nested, ok := operand.(And); ok {
+			operands = append(operands, nested...)
+		} else {
+			operands = append(operands, operand)
+		}
+
+		if this.peek() != TOKEN_AND {
+			break
+		}
+
+		this.next()
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+
+	return operands, nil
+}
+
+func (this *parser) parseUnary() (Expression, error) {
+	switch this.peek() {
+	case TOKEN_NOT:
+		this.next()
+
+		operand, err := this.parseUnary()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return negateExpression(operand), nil
+	case TOKEN_OPEN:
+		this.next()
+
+		expression, err := this.parseOr()
+
+		if err != nil {
+			return nil, err
 		}
+
+		if this.peek() != TOKEN_CLOSE {
+			return nil, this.unhandledTokenError()
+		}
+
+		this.next()
+
+		return expression, nil
+	case TOKEN_METHOD:
+		return this.parseMethod()
+	default:
+		return nil, this.unhandledTokenError()
+	}
+}
+
+func (this *parser) parseMethod() (*Method, error) {
+	method := &Method{
+		Name: this.next().value,
 	}
 
-	var methodGroups []Methods
-	var methods Methods
-	var method *Method
+	for {
+		switch this.peek() {
+		case TOKEN_ARG_INTEGER, TOKEN_ARG_FLOAT, TOKEN_ARG_BOOLEAN, TOKEN_ARG_NIL, TOKEN_ARG_STRING, TOKEN_ARG_REFERENCE, TOKEN_LIST_START:
+			if len(method.Keywords) > 0 {
+				token := this.tokens[this.position]
 
-	for _, token := range scanner.tokens {
-		switch token.type_ {
-		case TOKEN_GROUP:
-			methodGroups = append(methodGroups, methods)
-			methods = Methods{}
-		case TOKEN_METHOD:
-			method = &Method{
-				Name: token.value,
+				return nil, newError("parser.positionalAfterKeyword", "Positional argument at position {position} must come before the keyword arguments.", map[string]interface{}{
+					"position": token.position,
+				}).at(this.text, token.position, token.value)
 			}
-			methods = append(methods, method)
-		case TOKEN_ARG_INTEGER, TOKEN_ARG_FLOAT:
-			parsedValue, err := strconv.ParseFloat(token.value, 64)
+
+			value, err := this.parseArgument()
 
 			if err != nil {
-				return nil, errors.New(fmt.Sprintf("Argument '%s' at position %d is not a valid number.", token.value, token.position))
+				return nil, err
+			}
+
+			method.Arguments = append(method.Arguments, value)
+		case TOKEN_ARG_KEYWORD:
+			token := this.next()
+
+			if _, ok := method.Keywords.Get(token.value); ok {
+				return nil, newError("parser.duplicateKeyword", "Keyword argument '{keyword}' at position {position} is given more than once.", map[string]interface{}{
+					"keyword":  token.value,
+					"position": token.position,
+				}).at(this.text, token.position, token.value)
 			}
 
-			method.Arguments = append(method.Arguments, parsedValue)
-		case TOKEN_ARG_BOOLEAN:
-			parsedValue, err := strconv.ParseBool(token.value)
+			value, err := this.parseArgument()
 
 			if err != nil {
-				return nil, errors.New(fmt.Sprintf("Argument '%s' at position %d is not a valid boolean.", token.value, token.position))
+				return nil, err
 			}
 
-			method.Arguments = append(method.Arguments, parsedValue)
-		case TOKEN_ARG_NIL:
-			method.Arguments = append(method.Arguments, nil)
-		case TOKEN_ARG_STRING:
-			method.Arguments = append(method.Arguments, token.value)
-		case TOKEN_ERROR:
-			return nil, errors.New(token.value)
+			method.Keywords = append(method.Keywords, &Keyword{Name: token.value, Value: value})
 		default:
-			return nil, errors.New("Unable to parse. Unhandled token type.")
+			return method, nil
+		}
+	}
+}
+
+// parseArgument parses the value of a positional or keyword argument, which is a single value or a list.
+func (this *parser) parseArgument() (interface{}, error) {
+	if this.peek() == TOKEN_LIST_START {
+		return this.parseList()
+	}
+	return this.parseValue()
+}
+
+func (this *parser) parseList() (List, error) {
+	this.next()
+
+	list := List{}
+
+	for this.peek() != TOKEN_LIST_END {
+		value, err := this.parseValue()
+
+		if err != nil {
+			return nil, err
 		}
+
+		list = append(list, value)
 	}
 
-	methodGroups = append(methodGroups, methods)
+	this.next()
+
+	return list, nil
+}
+
+// parseInteger parses an integer literal into an int64, into an uint64 if it's too large for an int64 and into a
+// *big.Int if it's too large for both, so that no precision is lost.
+func parseInteger(text string) (interface{}, error) {
+	text = strings.Replace(text, "_", "", -1)
+	sign := ""
 
-	return methodGroups, nil
+	if text[0] == '+' || text[0] == '-' {
+		sign, text = text[:1], text[1:]
+	}
+
+	base := 10
+
+	if len(text) > 2 && text[0] == '0' {
+		switch text[1] {
+		case 'x', 'X':
+			base, text = 16, text[2:]
+		case 'b', 'B':
+			base, text = 2, text[2:]
+		}
+	}
+
+	if value, err := strconv.ParseInt(sign+text, base, 64); err == nil {
+		return value, nil
+	}
+
+	if sign != "-" {
+		if value, err := strconv.ParseUint(text, base, 64); err == nil {
+			return value, nil
+		}
+	}
+
+	if value, ok := new(big.Int).SetString(sign+text, base); ok {
+		return value, nil
+	}
+
+	return nil, strconv.ErrSyntax
+}
+
+func (this *parser) parseValue() (interface{}, error) {
+	token := this.next()
+
+	switch token.type_ {
+	case TOKEN_ARG_INTEGER, TOKEN_ARG_FLOAT:
+		var parsedValue interface{}
+		var err error
+
+		if token.type_ == TOKEN_ARG_INTEGER {
+			parsedValue, err = parseInteger(token.value)
+		} else {
+			parsedValue, err = strconv.ParseFloat(strings.Replace(token.value, "_", "", -1), 64)
+		}
+
+		if err != nil {
+			return nil, newError("parser.invalidNumber", "Argument '{argument}' at position {position} is not a valid number.", map[string]interface{}{
+				"argument": token.value,
+				"position": token.position,
+			}).at(this.text, token.position, token.value)
+		}
+
+		return parsedValue, nil
+	case TOKEN_ARG_BOOLEAN:
+		parsedValue, err := strconv.ParseBool(token.value)
+
+		if err != nil {
+			return nil, newError("parser.invalidBoolean", "Argument '{argument}' at position {position} is not a valid boolean.", map[string]interface{}{
+				"argument": token.value,
+				"position": token.position,
+			}).at(this.text, token.position, token.value)
+		}
+
+		return parsedValue, nil
+	case TOKEN_ARG_NIL:
+		return nil, nil
+	case TOKEN_ARG_STRING:
+		return token.value, nil
+	case TOKEN_ARG_REFERENCE:
+		return NewReference(token.value), nil
+	default:
+		return nil, this.unhandledTokenError()
+	}
 }
@@ -38,6 +38,47 @@ func TestThatWhenParsingSingleMethodWithSingleFloatArgumentItSucceeds(t *testing
 	testThatValidSyntaxIsParsedAsExpected(t, "abc(1.234)", "[{ name: 'abc', args: 1.234 }]")
 }
 
+func TestThatWhenParsingNumericArgumentsTheyKeepTheirKind(t *testing.T) {
+	methodGroups, err := Parse("abc(1, -0x1F, 0b101, 1_000_000, 010, 9223372036854775808, -9223372036854775809, 1.5, 1_0.2_5, 2e3, 1E-2, 3., +4)")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, but got %s.", err)
+	}
+
+	expected := "int64 1, int64 -31, int64 5, int64 1000000, int64 10, uint64 9223372036854775808, *big.Int -9223372036854775809, " +
+		"float64 1.5, float64 10.25, float64 2000, float64 0.01, float64 3, int64 4"
+
+	if actual := typedArguments(methodGroups[0][0].Arguments); actual != expected {
+		t.Fatalf("Expected '%s', but got '%s'.", expected, actual)
+	}
+}
+
+func typedArguments(args Arguments) string {
+	result := ""
+
+	for _, arg := range args {
+		if result != "" {
+			result += ", "
+		}
+		result += fmt.Sprintf("%T %v", arg, arg)
+	}
+
+	return result
+}
+
+func TestThatWhenParsingInvalidNumericArgumentsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "abc(1__0)", "Unexpected character U+005F '_' at position 7.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(10_)", "Unexpected character U+0029 ')' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(0x)", "Unexpected character U+0029 ')' at position 7.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(0b102)", "Unexpected character U+0032 '2' at position 9.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(0x1.5)", "Unexpected character U+002E '.' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(1.2.3)", "Unexpected character U+002E '.' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(1e)", "Unexpected character U+0029 ')' at position 7.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(1e5e5)", "Unexpected character U+0065 'e' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(-)", "Unexpected character U+0029 ')' at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(1e400)", "Argument '1e400' at position 4 is not a valid number.")
+}
+
 func TestThatWhenParsingSingleMethodWithSingleUnboundedStrArgumentItSucceeds(t *testing.T) {
 	testThatValidSyntaxIsParsedAsExpected(t, "abc(def)", "[{ name: 'abc', args: 'def' }]")
 }
@@ -94,6 +135,22 @@ func TestThatWhenPasingMethodWithBoundedTextArgAndEscapedValueItSucceeds(t *test
 	testThatValidSyntaxIsParsedAsExpected(t, "test(´test\\´´)", "[{ name: 'test', args: 'test´' }]")
 }
 
+func TestThatWhenParsingMethodWithQuotedTextArgItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "test('abc')", "[{ name: 'test', args: 'abc' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, `test('it\'s', 'a\\b', '^\d+$', 'a,b|c)')`, `[{ name: 'test', args: 'it's', 'a\b', '^\d+$', 'a,b|c)' }]`)
+	testThatValidSyntaxIsParsedAsExpected(t, `test("a\tb", "é\"", "'")`, "[{ name: 'test', args: 'a\tb', 'é\"', ''' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "test(`^\\d+\\\\$`, ``)", `[{ name: 'test', args: '^\d+\\$', '' }]`)
+	testThatValidSyntaxIsParsedAsExpected(t, "test(['a', \"b\", `c`, ´d´])", "[{ name: 'test', args: ['a', 'b', 'c', 'd'] }]")
+}
+
+func TestThatWhenParsingInvalidQuotedTextArgItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "test('abc)", "Unexpected end at position 10.")
+	testThatInvalidSyntaxFailsWithError(t, "test(`abc)", "Unexpected end at position 10.")
+	testThatInvalidSyntaxFailsWithError(t, `test("abc\")`, "Unexpected end at position 12.")
+	testThatInvalidSyntaxFailsWithError(t, `test("a\qb")`, `Invalid string "a\qb" at position 6.`)
+	testThatInvalidSyntaxFailsWithError(t, "test('a'b)", "Unexpected character U+0062 'b' at position 9.")
+}
+
 func TestThatWhenParsingValidMethodNameItSucceeds(t *testing.T) {
 	testThatValidSyntaxIsParsedAsExpected(t, "abc", "[{ name: 'abc', args: (none) }]")
 	testThatValidSyntaxIsParsedAsExpected(t, "Abc", "[{ name: 'Abc', args: (none) }]")
@@ -124,11 +181,17 @@ func TestThatWhenParsingInvalidMethodNameItFails(t *testing.T) {
 	testThatInvalidSyntaxFailsWithError(t, "(", "Unexpected character U+0028 '(' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, ")", "Unexpected character U+0029 ')' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, "|", "Unexpected character U+007C '|' at position 1.")
-	testThatInvalidSyntaxFailsWithError(t, "´", "Unexpected character U+00B4 '´' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "´", "Unexpected character U+00B4 '´' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, "1", "Unexpected character U+0031 '1' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, "_Test()", "Unexpected character U+005F '_' at position 1.")
 }
 
+func TestThatWhenParsingNonASCIICharactersTheOffendingRuneIsReported(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "min(5)é", "Unexpected character U+00E9 'é' at position 7.")
+	testThatInvalidSyntaxFailsWithError(t, "a,€", "Unexpected character U+20AC '€' at position 3.")
+	testThatInvalidSyntaxFailsWithError(t, "é", "Unexpected character U+00E9 'é' at position 1.")
+}
+
 func TestThatWhenParsingMethodNamesWithInvalidSeparatorsItFails(t *testing.T) {
 	testThatInvalidSyntaxFailsWithError(t, ",test", "Unexpected character U+002C ',' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, "test,", "Unexpected character U+002C ',' at position 5.")
@@ -152,3 +215,228 @@ func TestThatWhenParsingInvalidGroupSeparatorsItFails(t *testing.T) {
 	testThatInvalidSyntaxFailsWithError(t, "|a|", "Unexpected character U+007C '|' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, "||", "Unexpected character U+007C '|' at position 1.")
 }
+
+func TestThatParseErrorsHaveLocaleKeysAndParams(t *testing.T) {
+	_, err := Parse("test(,)")
+
+	parseErr, ok := err.(*ParseError)
+
+	if !ok {
+		t.Fatalf("Expected a parse error, but got '%v'.", err)
+	}
+
+	if parseErr.Key != "parser.unexpectedCharacter" || parseErr.Params["position"] != 6 || parseErr.Params["character"] != "U+002C ','" {
+		t.Fatalf("Expected key 'parser.unexpectedCharacter' with position 6 and character U+002C, but got '%s' %v.", parseErr.Key, parseErr.Params)
+	}
+
+	parseErr.Text = "Zeichen {character} an Position {position}."
+
+	if parseErr.Error() != "Zeichen U+002C ',' an Position 6." {
+		t.Fatalf("Expected translated error, but got '%s'.", parseErr.Error())
+	}
+}
+
+func TestThatWhenParsingNegatedMethodsItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "!abc", "[{ name: 'abc', args: (none), negated: true }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc,!def(´x´)", "[{ name: 'abc', args: (none) }, { name: 'def', args: 'x', negated: true }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc|!def", "[{ name: 'abc', args: (none) } { name: 'def', args: (none), negated: true }]")
+}
+
+func TestThatWhenParsingInvalidNegationsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "!", "Unexpected end at position 1.")
+	testThatInvalidSyntaxFailsWithError(t, "!!abc", "Unexpected character U+0021 '!' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "! abc", "Unexpected character U+0020 ' ' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "abc!", "Unexpected character U+0021 '!' at position 4.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(!1)", "Unexpected character U+0021 '!' at position 5.")
+}
+
+func TestThatMethodCallIsFormattedAsWrittenInTag(t *testing.T) {
+	methodGroups, _ := Parse("!abc(´it\\´s\\\\´, 1.5, true, nil)|def")
+
+	if call := methodGroups[0][0].Call(); call != "abc(´it\\´s\\\\´, 1.5, true, nil)" {
+		t.Fatalf("Expected call 'abc(´it\\´s\\\\´, 1.5, true, nil)', but got '%s'.", call)
+	}
+
+	if call := methodGroups[1][0].Call(); call != "def" {
+		t.Fatalf("Expected call 'def', but got '%s'.", call)
+	}
+}
+
+func testThatExpressionIsParsedAsExpected(t *testing.T, test string, expected string) {
+	expression, err := ParseExpression(test)
+
+	if err != nil {
+		t.Fatalf("Tested '%s'. Didn't expect error, but got %s.", test, err)
+	}
+
+	if expected != fmt.Sprint(expression) {
+		t.Fatalf("Tested '%s'. Expected '%s' but got '%s'.", test, expected, fmt.Sprint(expression))
+	}
+}
+
+func TestThatWhenParsingNestedExpressionsItSucceeds(t *testing.T) {
+	testThatExpressionIsParsedAsExpected(t, "abc", "{ name: 'abc', args: (none) }")
+	testThatExpressionIsParsedAsExpected(t, "(abc)", "{ name: 'abc', args: (none) }")
+	testThatExpressionIsParsedAsExpected(t, "abc,def|ghi", "or(and({ name: 'abc', args: (none) }, { name: 'def', args: (none) }), { name: 'ghi', args: (none) })")
+	testThatExpressionIsParsedAsExpected(t, "abc,(def|ghi)", "and({ name: 'abc', args: (none) }, or({ name: 'def', args: (none) }, { name: 'ghi', args: (none) }))")
+	testThatExpressionIsParsedAsExpected(t, "(abc,def),ghi", "and({ name: 'abc', args: (none) }, { name: 'def', args: (none) }, { name: 'ghi', args: (none) })")
+	testThatExpressionIsParsedAsExpected(t, "!(abc|def)", "not(or({ name: 'abc', args: (none) }, { name: 'def', args: (none) }))")
+	testThatExpressionIsParsedAsExpected(t, "!(abc)", "{ name: 'abc', args: (none), negated: true }")
+	testThatExpressionIsParsedAsExpected(t, "!(!abc)", "{ name: 'abc', args: (none) }")
+	testThatExpressionIsParsedAsExpected(t, "!(!(abc|def))", "or({ name: 'abc', args: (none) }, { name: 'def', args: (none) })")
+	testThatExpressionIsParsedAsExpected(t, "!(!(!abc))", "{ name: 'abc', args: (none), negated: true }")
+	testThatExpressionIsParsedAsExpected(t, " ( empty | ( numeric , min(5) ) ) , max(10) ", "and(or({ name: 'empty', args: (none) }, and({ name: 'numeric', args: (none) }, { name: 'min', args: 5 })), { name: 'max', args: 10 })")
+}
+
+func TestThatWhenParsingNestedExpressionsMethodGroupsAreTopLevelAlternatives(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "abc|(def|ghi,jkl)", "[{ name: 'abc', args: (none) } { name: 'def', args: (none) } { name: 'ghi', args: (none) }, { name: 'jkl', args: (none) }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "(abc|def),ghi", "[{ name: 'abc', args: (none) }, { name: 'def', args: (none) }, { name: 'ghi', args: (none) }]")
+}
+
+func TestThatWhenParsingUnbalancedParenthesesItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "(abc", "Unexpected end at position 4.")
+	testThatInvalidSyntaxFailsWithError(t, "abc)", "Unexpected character U+0029 ')' at position 4.")
+	testThatInvalidSyntaxFailsWithError(t, "(abc))", "Unexpected character U+0029 ')' at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "()", "Unexpected character U+0029 ')' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "(abc|)", "Unexpected character U+0029 ')' at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "abc (def)", "Unexpected character U+0028 '(' at position 5.")
+}
+
+func TestThatExpressionCallIsFormattedAsWrittenInTag(t *testing.T) {
+	expression, _ := ParseExpression("(empty|!(numeric,min(5))),!max(10)")
+
+	if call := expression.Call(); call != "(empty | !(numeric, min(5))), !max(10)" {
+		t.Fatalf("Expected call '(empty | !(numeric, min(5))), !max(10)', but got '%s'.", call)
+	}
+}
+
+func TestThatWhenParsingListArgumentsItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "abc([def, 1, 1.5, ´g h´, true, nil])", "[{ name: 'abc', args: ['def', 1, 1.5, 'g h', true, <nil>] }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc( [ def ,1 ] , 2)", "[{ name: 'abc', args: ['def', 1], 2 }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc([]),def([x])", "[{ name: 'abc', args: [] }, { name: 'def', args: ['x'] }]")
+
+	methodGroups, _ := Parse("abc([1, ´x´])")
+
+	if list, ok := methodGroups[0][0].Arguments[0].(List); !ok || list[0] != int64(1) || list[1] != "x" {
+		t.Fatalf("Expected a list of typed values, but got %#v.", methodGroups[0][0].Arguments[0])
+	}
+
+	if call := methodGroups[0][0].Call(); call != "abc([1, ´x´])" {
+		t.Fatalf("Expected call 'abc([1, ´x´])', but got '%s'.", call)
+	}
+}
+
+func TestThatWhenParsingInvalidListArgumentsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "abc([1,])", "Unexpected character U+005D ']' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "abc([[1]])", "Unexpected character U+005B '[' at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "abc([1)", "Unexpected character U+0029 ')' at position 7.")
+	testThatInvalidSyntaxFailsWithError(t, "abc([1", "Unexpected end at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "abc(1])", "Unexpected character U+005D ']' at position 6.")
+}
+
+func TestThatWhenParsingReferenceArgumentsItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "abc($Password)", "[{ name: 'abc', args: $Password }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc($root.Limits.MaxItems, $ctx.tenant_max)", "[{ name: 'abc', args: $root.Limits.MaxItems, $ctx.tenant_max }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc([1, $Other])", "[{ name: 'abc', args: [1, $Other] }]")
+
+	methodGroups, _ := Parse("abc($root.Limits.Max2, ´$x´)")
+	reference, ok := methodGroups[0][0].Arguments[0].(*Reference)
+
+	if !ok || reference.Scope != ReferenceRoot || fmt.Sprint(reference.Path) != "[Limits Max2]" {
+		t.Fatalf("Expected a root reference to Limits.Max2, but got %#v.", methodGroups[0][0].Arguments[0])
+	}
+
+	if methodGroups[0][0].Arguments[1] != "$x" {
+		t.Fatalf("Expected quoted text to stay a string, but got %#v.", methodGroups[0][0].Arguments[1])
+	}
+
+	if !methodGroups[0][0].Arguments.HasReferences() {
+		t.Fatalf("Expected arguments to have references.")
+	}
+
+	if call := methodGroups[0][0].Call(); call != "abc($root.Limits.Max2, ´$x´)" {
+		t.Fatalf("Expected call 'abc($root.Limits.Max2, ´$x´)', but got '%s'.", call)
+	}
+}
+
+func TestThatWhenParsingInvalidReferenceArgumentsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "abc($)", "Unexpected character U+0029 ')' at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "abc($a.)", "Unexpected character U+0029 ')' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "abc($a..b)", "Unexpected character U+002E '.' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "abc($1a)", "Unexpected character U+0031 '1' at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "abc($a-b)", "Unexpected character U+002D '-' at position 7.")
+}
+
+func TestThatParseErrorsAreLocatedInTag(t *testing.T) {
+	tests := []struct {
+		tag     string
+		offset  int
+		token   string
+		snippet string
+	}{
+		{"min(1,,2)", 6, ",", "min(1,,2)\n      ^"},
+		{"(empty|min(1)", 13, "", "(empty|min(1)\n             ^"},
+		{"´abc", 0, "´", "´abc\n^"},
+		{"a,\t´b", 3, "´", "a,\t´b\n  \t^"},
+		{"a,€", 2, "€", "a,€\n  ^"},
+		{`in("\x")`, 3, `"\x"`, "in(\"\\x\")\n   ^~~~"},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.tag)
+
+		parseErr, ok := err.(*ParseError)
+
+		if !ok {
+			t.Fatalf("Tested '%s'. Expected a parse error, but got '%v'.", test.tag, err)
+		}
+
+		if parseErr.Tag != test.tag || parseErr.Offset != test.offset || parseErr.Token != test.token {
+			t.Fatalf("Tested '%s'. Expected offset %d and token '%s', but got %d and '%s'.", test.tag, test.offset, test.token, parseErr.Offset, parseErr.Token)
+		}
+
+		if snippet := parseErr.Snippet(); snippet != test.snippet {
+			t.Fatalf("Tested '%s'. Expected snippet %q, but got %q.", test.tag, test.snippet, snippet)
+		}
+	}
+
+	_, err := ParseAlias("a(b", "min(1)")
+
+	if snippet := err.(*ParseError).Snippet(); snippet != "a(b\n^~~" {
+		t.Fatalf("Expected the whole declaration to be marked, but got %q.", snippet)
+	}
+}
+
+func TestThatWhenParsingKeywordArgumentsItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "len(min=3, max=16)", "[{ name: 'len', args: (none), keywords: min=3, max=16 }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "time(layout=´2006-01-02´,after = 'now')", "[{ name: 'time', args: (none), keywords: layout='2006-01-02', after='now' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc(1, x, y=[a, 2], z=$root.Max)", "[{ name: 'abc', args: 1, 'x', keywords: y=['a', 2], z=$root.Max }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "!abc(a='=')|def", "[{ name: 'abc', args: (none), keywords: a='=', negated: true } { name: 'def', args: (none) }]")
+
+	methodGroups, _ := Parse("len(3, max=16)")
+	method := methodGroups[0][0]
+
+	if value, ok := method.Keywords.Get("max"); !ok || value != int64(16) {
+		t.Fatalf("Expected keyword argument max=16, but got %v.", value)
+	}
+
+	if call := method.Call(); call != "len(3, max=16)" {
+		t.Fatalf("Expected call 'len(3, max=16)', but got '%s'.", call)
+	}
+
+	methodGroups, _ = Parse("abc(x=$Other)")
+
+	if !methodGroups[0][0].HasReferences() || methodGroups[0][0].Arguments.HasReferences() {
+		t.Fatalf("Expected the reference of the keyword argument to be found.")
+	}
+}
+
+func TestThatWhenParsingInvalidKeywordArgumentsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "len(max=16, 3)", "Positional argument at position 12 must come before the keyword arguments.")
+	testThatInvalidSyntaxFailsWithError(t, "len(max=16, max=17)", "Keyword argument 'max' at position 12 is given more than once.")
+	testThatInvalidSyntaxFailsWithError(t, "len(max=)", "Unexpected character U+0029 ')' at position 9.")
+	testThatInvalidSyntaxFailsWithError(t, "len(max 16)", "Unexpected character U+0031 '1' at position 9.")
+	testThatInvalidSyntaxFailsWithError(t, "len([max=16])", "Unexpected character U+003D '=' at position 9.")
+	testThatInvalidSyntaxFailsWithError(t, "len('max'=16)", "Unexpected character U+003D '=' at position 10.")
+	testThatInvalidSyntaxFailsWithError(t, "len(1=16)", "Unexpected character U+003D '=' at position 6.")
+}
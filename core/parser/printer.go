@@ -0,0 +1,175 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Format parses a tag and returns it in canonical syntax, see Print.
+func Format(tag string) (string, error) {
+	expression, err := ParseExpression(tag)
+
+	if err != nil {
+		return "", err
+	}
+
+	return Print(expression), nil
+}
+
+// Print returns an expression in the canonical syntax of tags. Operands are separated by ',' and '|' without spaces,
+// arguments by ", ", methods without arguments have no parentheses and expressions are only parenthesized where it's
+// needed. Strings are written bare if they're names, in single quotes if they're printable and in double quotes
+// otherwise. Parsing the result yields the same expression. Print returns "" for a nil expression.
+func Print(expression Expression) string {
+	switch typedExpression := expression.(type) {
+	case nil:
+		return ""
+	case *Method:
+		return printMethod(typedExpression)
+	case And:
+		operands := make([]string, len(typedExpression))
+
+		for i, operand := range typedExpression {
+			// Or binds weaker than And, so it needs parentheses inside of it.
+			if _, ok := operand.(Or); ok {
+				operands[i] = "(" + Print(operand) + ")"
+			} else {
+				operands[i] = Print(operand)
+			}
+		}
+
+		return strings.Join(operands, ",")
+	case Or:
+		operands := make([]string, len(typedExpression))
+
+		for i, operand := range typedExpression {
+			operands[i] = Print(operand)
+		}
+
+		return strings.Join(operands, "|")
+	case *Not:
+		return "!(" + Print(typedExpression.Operand) + ")"
+	default:
+		return expression.Call()
+	}
+}
+
+// PrintGroups returns method groups, as returned by Parse, in the canonical syntax of tags. Groups only keep the
+// methods of nested expressions, so use Print to keep their structure.
+func PrintGroups(groups []Methods) string {
+	printedGroups := make([]string, len(groups))
+
+	for i, group := range groups {
+		methods := make([]string, len(group))
+
+		for j, method := range group {
+			methods[j] = printMethod(method)
+		}
+
+		printedGroups[i] = strings.Join(methods, ",")
+	}
+
+	return strings.Join(printedGroups, "|")
+}
+
+func printMethod(method *Method) string {
+	result := method.Name
+
+	if method.Negated {
+		result = "!" + result
+	}
+
+	if len(method.Arguments) == 0 && len(method.Keywords) == 0 {
+		return result
+	}
+
+	args := make([]string, 0, len(method.Arguments)+len(method.Keywords))
+
+	if len(method.Arguments) > 0 {
+		args = append(args, printArguments(method.Arguments))
+	}
+
+	for _, keyword := range method.Keywords {
+		args = append(args, keyword.Name+"="+printArgument(keyword.Value))
+	}
+
+	return result + "(" + strings.Join(args, ", ") + ")"
+}
+
+func printArguments(args []interface{}) string {
+	values := make([]string, len(args))
+
+	for i, arg := range args {
+		values[i] = printArgument(arg)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func printArgument(arg interface{}) string {
+	switch typedArg := arg.(type) {
+	case string:
+		return printText(typedArg)
+	case float64:
+		return formatFloat(typedArg)
+	case bool:
+		return strconv.FormatBool(typedArg)
+	case nil:
+		return "nil"
+	case *Reference:
+		return typedArg.String()
+	case List:
+		return "[" + printArguments(typedArg) + "]"
+	default:
+		return callArgument(arg)
+	}
+}
+
+// printText writes a string bare if it lexes back to the same string, and quoted otherwise.
+func printText(text string) string {
+	if isBareText(text) {
+		return text
+	}
+
+	for _, char := range text {
+		if !strconv.IsPrint(char) {
+			return strconv.Quote(text)
+		}
+	}
+
+	var result strings.Builder
+
+	result.WriteRune('\'')
+
+	for i, char := range text {
+		switch {
+		case char == '\'':
+			result.WriteString("\\'")
+		case char == '\\' && (i+1 == len(text) || text[i+1] == '\'' || text[i+1] == '\\' || (i > 0 && text[i-1] == '\\')):
+			// The lexer keeps a single backslash before other characters, so only backslashes that would form an escape
+			// are doubled. A pattern is written as '^\d+$', but two backslashes before a 'd' as '\\\\d'.
+			result.WriteString("\\\\")
+		default:
+			result.WriteRune(char)
+		}
+	}
+
+	result.WriteRune('\'')
+
+	return result.String()
+}
+
+func isBareText(text string) bool {
+	switch text {
+	case "", "true", "false", "nil", "null":
+		return false
+	}
+
+	for i, char := range text {
+		if !isAlpha(char) && (i == 0 || (!isNumeric(char) && char != '_')) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,86 @@
+package parser_test
+
+import (
+	. "github.com/typerandom/validator/core/parser"
+	"reflect"
+	"testing"
+)
+
+func testThatTagIsFormattedAsExpected(t *testing.T, test string, expected string) {
+	formatted, err := Format(test)
+
+	if err != nil {
+		t.Fatalf("Tested '%s'. Didn't expect error, but got %s.", test, err)
+	}
+
+	if formatted != expected {
+		t.Fatalf("Tested '%s'. Expected '%s' but got '%s'.", test, expected, formatted)
+	}
+
+	// The canonical form must parse to the same expression and format to itself.
+	original, _ := ParseExpression(test)
+	reparsed, err := ParseExpression(formatted)
+
+	if err != nil {
+		t.Fatalf("Tested '%s'. Didn't expect error when parsing '%s', but got %s.", test, formatted, err)
+	}
+
+	if !reflect.DeepEqual(original, reparsed) {
+		t.Fatalf("Tested '%s'. Expected '%s' to parse to %v, but got %v.", test, formatted, original, reparsed)
+	}
+
+	if again := Print(reparsed); again != formatted {
+		t.Fatalf("Tested '%s'. Expected '%s' to be stable, but got '%s'.", test, formatted, again)
+	}
+}
+
+func TestThatTagsAreFormattedInCanonicalSyntax(t *testing.T) {
+	testThatTagIsFormattedAsExpected(t, "", "")
+	testThatTagIsFormattedAsExpected(t, "  min( 5 ) ,max(16)  ", "min(5),max(16)")
+	testThatTagIsFormattedAsExpected(t, "empty() | min(1,2)", "empty|min(1, 2)")
+	testThatTagIsFormattedAsExpected(t, "(empty | (lowercase, min(5))), max(10)", "(empty|lowercase,min(5)),max(10)")
+	testThatTagIsFormattedAsExpected(t, "!(empty | lowercase), !uppercase", "!(empty|lowercase),!uppercase")
+	testThatTagIsFormattedAsExpected(t, "((a))", "a")
+	testThatTagIsFormattedAsExpected(t, "!(!a), !(!(b|c))", "a,(b|c)")
+}
+
+func TestThatArgumentsAreFormattedInCanonicalSyntax(t *testing.T) {
+	testThatTagIsFormattedAsExpected(t, "a(´abc´, \"def\", `g_1`)", "a(abc, def, g_1)")
+	testThatTagIsFormattedAsExpected(t, "a(´power user´, ´it's´, ´true´, ´nil´, ´1a´, ´´)", `a('power user', 'it\'s', 'true', 'nil', '1a', '')`)
+	testThatTagIsFormattedAsExpected(t, "a(`^\\d+$`, `a\\\\`, `\\'`, `\\\\d`)", `a('^\d+$', 'a\\\\', '\\\'', '\\\\d')`)
+	testThatTagIsFormattedAsExpected(t, `a("a\tb", "é")`, `a("a\tb", 'é')`)
+	testThatTagIsFormattedAsExpected(t, "a(1, 1.50, -2.0, +3, 0.125)", "a(1, 1.5, -2.0, 3, 0.125)")
+	testThatTagIsFormattedAsExpected(t, "a(0xFF, 0b11, 1_000, 1e3, 1.5e-7, 18446744073709551616)", "a(255, 3, 1000, 1000.0, 1.5e-07, 18446744073709551616)")
+	testThatTagIsFormattedAsExpected(t, "a(true, false, null)", "a(true, false, nil)")
+	testThatTagIsFormattedAsExpected(t, "a([ x, ´y z´ ], [])", "a([x, 'y z'], [])")
+}
+
+func TestThatReferencesAndListsAreFormattedInCanonicalSyntax(t *testing.T) {
+	testThatTagIsFormattedAsExpected(t, "equal( $Password ), max($root.Limits.Max)", "equal($Password),max($root.Limits.Max)")
+	testThatTagIsFormattedAsExpected(t, "equal([ admin,´power user´ , 1.5, nil ])", "equal([admin, 'power user', 1.5, nil])")
+}
+
+func TestThatKeywordArgumentsAreFormattedInCanonicalSyntax(t *testing.T) {
+	testThatTagIsFormattedAsExpected(t, "len( min = 3,max=16 )", "len(min=3, max=16)")
+	testThatTagIsFormattedAsExpected(t, "time(´2006´, after=´next week´, list=[ a ])", "time('2006', after='next week', list=[a])")
+}
+
+func TestThatMethodGroupsAreFormattedInCanonicalSyntax(t *testing.T) {
+	groups, _ := Parse("min( 1 ), max(2) | empty | !lowercase")
+
+	if printed := PrintGroups(groups); printed != "min(1),max(2)|empty|!lowercase" {
+		t.Fatalf("Expected 'min(1),max(2)|empty|!lowercase', but got '%s'.", printed)
+	}
+
+	groups, _ = Parse("")
+
+	if printed := PrintGroups(groups); printed != "" {
+		t.Fatalf("Expected '', but got '%s'.", printed)
+	}
+}
+
+func TestThatFormattingInvalidTagsFails(t *testing.T) {
+	if _, err := Format("min(1,,2)"); err == nil {
+		t.Fatal("Expected error, but got none.")
+	}
+}
@@ -12,6 +12,12 @@ type scanner struct {
 	position int
 	width    int
 
+	// depth is the number of nested expressions that are open.
+	depth int
+
+	// inList indicates whether or not the values that are lexed are in a list argument.
+	inList bool
+
 	tokens []*token
 }
 
@@ -63,19 +69,29 @@ func (this *scanner) emitValue(typ tokenType, value string) {
 	this.skip()
 }
 
-func (this *scanner) errorf(format string, args ...interface{}) lexer {
+func (this *scanner) error(err *ParseError) lexer {
 	this.tokens = append(this.tokens, &token{
 		type_:    TOKEN_ERROR,
 		position: this.start,
-		value:    fmt.Sprintf(format, args...),
+		value:    err.Error(),
+		err:      err,
 	})
 	return nil
 }
 
+// unexpectedCharError reports the character before the current position, at the 1-based position of its first byte.
 func (this *scanner) unexpectedCharError() lexer {
-	return this.errorf("Unexpected character %#U at position %d.", this.value[this.position-1], this.position)
+	char, size := utf8.DecodeLastRuneInString(this.value[:this.position])
+	start := this.position - size
+
+	return this.error(newError("parser.unexpectedCharacter", "Unexpected character {character} at position {position}.", map[string]interface{}{
+		"character": fmt.Sprintf("%#U", char),
+		"position":  start + 1,
+	}).at(this.value, start, this.value[start:this.position]))
 }
 
 func (this *scanner) UnexpectedEndError() lexer {
-	return this.errorf("Unexpected end at position %d.", this.position)
+	return this.error(newError("parser.unexpectedEnd", "Unexpected end at position {position}.", map[string]interface{}{
+		"position": this.position,
+	}).at(this.value, this.position, ""))
 }
@@ -12,6 +12,7 @@ type token struct {
 	type_    tokenType
 	position int
 	value    string
+	err      *ParseError
 }
 
 const (
@@ -22,12 +23,20 @@ const (
 
 	TOKEN_GROUP
 	TOKEN_METHOD
+	TOKEN_NOT
+	TOKEN_AND
+	TOKEN_OPEN
+	TOKEN_CLOSE
 
 	TOKEN_ARG_INTEGER
 	TOKEN_ARG_FLOAT
 	TOKEN_ARG_STRING
 	TOKEN_ARG_BOOLEAN
 	TOKEN_ARG_NIL
+	TOKEN_ARG_REFERENCE
+	TOKEN_ARG_KEYWORD
+	TOKEN_LIST_START
+	TOKEN_LIST_END
 )
 
 func (this token) String() string {
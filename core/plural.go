@@ -0,0 +1,229 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// PluralCategory is a CLDR plural category.
+type PluralCategory string
+
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+// pluralCategories lists the categories in the order they're conventionally written in.
+var pluralCategories = []PluralCategory{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther}
+
+// PluralOperands are the CLDR plural operands of a number.
+type PluralOperands struct {
+	// N is the absolute value of the number.
+	N float64
+
+	// I is the integer part of the number.
+	I int64
+
+	// V is the number of visible fraction digits, including trailing zeros.
+	V int
+
+	// F is the visible fraction digits as an integer, including trailing zeros.
+	F int64
+}
+
+// NewPluralOperands calculates the plural operands of a number, which can be of any numeric type or a numeric string.
+// Returns false if the value isn't a number.
+func NewPluralOperands(value interface{}) (*PluralOperands, bool) {
+	text := strings.TrimPrefix(fmt.Sprint(value), "-")
+
+	n, err := strconv.ParseFloat(text, 64)
+
+	if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+		return nil, false
+	}
+
+	operands := &PluralOperands{
+		N: n,
+		I: int64(n),
+	}
+
+	if i := strings.IndexByte(text, '.'); i >= 0 && !strings.ContainsAny(text, "eE") {
+		fraction := text[i+1:]
+		operands.V = len(fraction)
+		operands.F, _ = strconv.ParseInt(fraction, 10, 64)
+	}
+
+	return operands, true
+}
+
+// PluralRule selects the plural category of a number.
+type PluralRule func(operands *PluralOperands) PluralCategory
+
+func inRange(value int64, from int64, to int64) bool {
+	return value >= from && value <= to
+}
+
+func pluralRuleOneForOnlyOne(o *PluralOperands) PluralCategory {
+	if o.I == 1 && o.V == 0 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralRuleOneForZeroAndOne(o *PluralOperands) PluralCategory {
+	if o.I == 0 || o.I == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralRuleOnlyOther(o *PluralOperands) PluralCategory {
+	return PluralOther
+}
+
+func pluralRuleEastSlavic(o *PluralOperands) PluralCategory {
+	if o.V != 0 {
+		return PluralOther
+	}
+
+	switch mod10, mod100 := o.I%10, o.I%100; {
+	case mod10 == 1 && mod100 != 11:
+		return PluralOne
+	case inRange(mod10, 2, 4) && !inRange(mod100, 12, 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+func pluralRulePolish(o *PluralOperands) PluralCategory {
+	if o.V != 0 {
+		return PluralOther
+	}
+
+	switch mod10, mod100 := o.I%10, o.I%100; {
+	case o.I == 1:
+		return PluralOne
+	case inRange(mod10, 2, 4) && !inRange(mod100, 12, 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+func pluralRuleWestSlavic(o *PluralOperands) PluralCategory {
+	switch {
+	case o.V != 0:
+		return PluralMany
+	case o.I == 1:
+		return PluralOne
+	case inRange(o.I, 2, 4):
+		return PluralFew
+	default:
+		return PluralOther
+	}
+}
+
+func pluralRuleArabic(o *PluralOperands) PluralCategory {
+	if o.V != 0 || o.N != float64(o.I) {
+		return PluralOther
+	}
+
+	switch mod100 := o.I % 100; {
+	case o.I == 0:
+		return PluralZero
+	case o.I == 1:
+		return PluralOne
+	case o.I == 2:
+		return PluralTwo
+	case inRange(mod100, 3, 10):
+		return PluralFew
+	case inRange(mod100, 11, 99):
+		return PluralMany
+	default:
+		return PluralOther
+	}
+}
+
+var (
+	pluralRules = map[string]PluralRule{
+		"en":    pluralRuleOneForOnlyOne,
+		"de":    pluralRuleOneForOnlyOne,
+		"nl":    pluralRuleOneForOnlyOne,
+		"sv":    pluralRuleOneForOnlyOne,
+		"da":    pluralRuleOneForOnlyOne,
+		"nb":    pluralRuleOneForOnlyOne,
+		"fi":    pluralRuleOneForOnlyOne,
+		"it":    pluralRuleOneForOnlyOne,
+		"es":    pluralRuleOneForOnlyOne,
+		"pt-PT": pluralRuleOneForOnlyOne,
+		"pt":    pluralRuleOneForZeroAndOne,
+		"fr":    pluralRuleOneForZeroAndOne,
+		"ru":    pluralRuleEastSlavic,
+		"uk":    pluralRuleEastSlavic,
+		"pl":    pluralRulePolish,
+		"cs":    pluralRuleWestSlavic,
+		"sk":    pluralRuleWestSlavic,
+		"ar":    pluralRuleArabic,
+		"ja":    pluralRuleOnlyOther,
+		"ko":    pluralRuleOnlyOther,
+		"zh":    pluralRuleOnlyOther,
+		"vi":    pluralRuleOnlyOther,
+		"th":    pluralRuleOnlyOther,
+		"id":    pluralRuleOnlyOther,
+	}
+	pluralRulesLock sync.RWMutex
+)
+
+// RegisterPluralRule registers the plural rule of a language, replacing the embedded one if there is any.
+func RegisterPluralRule(tag string, rule PluralRule) {
+	pluralRulesLock.Lock()
+	defer pluralRulesLock.Unlock()
+
+	pluralRules[NormalizeTag(tag)] = rule
+}
+
+// GetPluralRule returns the plural rule of a language. Subtags are removed until a rule is found, i.e. the rule of
+// "de-AT" is the one of "de". Languages without any rule use the English one.
+func GetPluralRule(tag string) PluralRule {
+	pluralRulesLock.RLock()
+	defer pluralRulesLock.RUnlock()
+
+	for tag = NormalizeTag(tag); len(tag) > 0; {
+		if rule, ok := pluralRules[tag]; ok {
+			return rule
+		}
+
+		i := strings.LastIndex(tag, "-")
+
+		if i < 0 {
+			break
+		}
+
+		tag = tag[:i]
+	}
+
+	return pluralRuleOneForOnlyOne
+}
+
+// PluralMessage builds a message that selects one of several forms by the plural category of a named parameter,
+// i.e. PluralMessage("min", map[PluralCategory]string{PluralOne: "# character", PluralOther: "# characters"}).
+// In the forms, # is replaced by the number. See RenderMessage for the syntax of the result.
+func PluralMessage(param string, forms map[PluralCategory]string) string {
+	message := "{" + param + ", plural,"
+
+	for _, category := range pluralCategories {
+		if form, ok := forms[category]; ok {
+			message += " " + string(category) + " {" + form + "}"
+		}
+	}
+
+	return message + "}"
+}
@@ -0,0 +1,78 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"testing"
+)
+
+func testThatPluralCategoriesAre(t *testing.T, tag string, expected map[interface{}]PluralCategory) {
+	rule := GetPluralRule(tag)
+
+	for number, category := range expected {
+		operands, ok := NewPluralOperands(number)
+
+		if !ok {
+			t.Fatalf("Expected '%v' to be a number, but it wasn't.", number)
+		}
+
+		if actual := rule(operands); actual != category {
+			t.Fatalf("Expected '%v' to be '%s' in '%s', but got '%s'.", number, category, tag, actual)
+		}
+	}
+}
+
+func TestThatPluralOperandsAreCalculated(t *testing.T) {
+	operands, ok := NewPluralOperands("-12.50")
+
+	if !ok || operands.N != 12.5 || operands.I != 12 || operands.V != 2 || operands.F != 50 {
+		t.Fatalf("Expected operands n=12.5, i=12, v=2, f=50, but got %+v.", operands)
+	}
+
+	if _, ok := NewPluralOperands("abc"); ok {
+		t.Fatal("Didn't expect 'abc' to be a number, but it was.")
+	}
+}
+
+func TestThatEnglishPluralRuleIsApplied(t *testing.T) {
+	testThatPluralCategoriesAre(t, "en-US", map[interface{}]PluralCategory{0: PluralOther, 1: PluralOne, 1.0: PluralOne, "1.0": PluralOther, 2: PluralOther})
+}
+
+func TestThatFrenchPluralRuleIsApplied(t *testing.T) {
+	testThatPluralCategoriesAre(t, "fr", map[interface{}]PluralCategory{0: PluralOne, 1: PluralOne, "1.5": PluralOne, 2: PluralOther})
+}
+
+func TestThatRussianPluralRuleIsApplied(t *testing.T) {
+	testThatPluralCategoriesAre(t, "ru", map[interface{}]PluralCategory{1: PluralOne, 21: PluralOne, 11: PluralMany, 2: PluralFew, 24: PluralFew, 12: PluralMany, 5: PluralMany, 100: PluralMany, "1.5": PluralOther})
+}
+
+func TestThatPolishPluralRuleIsApplied(t *testing.T) {
+	testThatPluralCategoriesAre(t, "pl", map[interface{}]PluralCategory{1: PluralOne, 21: PluralMany, 22: PluralFew, 12: PluralMany, 5: PluralMany})
+}
+
+func TestThatCzechPluralRuleIsApplied(t *testing.T) {
+	testThatPluralCategoriesAre(t, "cs", map[interface{}]PluralCategory{1: PluralOne, 3: PluralFew, 5: PluralOther, "1.5": PluralMany})
+}
+
+func TestThatArabicPluralRuleIsApplied(t *testing.T) {
+	testThatPluralCategoriesAre(t, "ar", map[interface{}]PluralCategory{0: PluralZero, 1: PluralOne, 2: PluralTwo, 3: PluralFew, 11: PluralMany, 100: PluralOther})
+}
+
+func TestThatJapanesePluralRuleIsApplied(t *testing.T) {
+	testThatPluralCategoriesAre(t, "ja", map[interface{}]PluralCategory{0: PluralOther, 1: PluralOther})
+}
+
+func TestThatPluralRuleCanBeRegistered(t *testing.T) {
+	RegisterPluralRule("x-test", func(operands *PluralOperands) PluralCategory {
+		return PluralMany
+	})
+
+	testThatPluralCategoriesAre(t, "x-test-AB", map[interface{}]PluralCategory{1: PluralMany})
+}
+
+func TestThatPluralMessageIsBuiltInCategoryOrder(t *testing.T) {
+	message := PluralMessage("count", map[PluralCategory]string{PluralOther: "# items", PluralOne: "# item", PluralFew: "# itemy"})
+
+	if message != "{count, plural, one {# item} few {# itemy} other {# items}}" {
+		t.Fatalf("Unexpected plural message '%s'.", message)
+	}
+}
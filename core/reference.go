@@ -0,0 +1,121 @@
+package core
+
+import (
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+)
+
+// ResolvePath follows a path of field names, or keys of maps with string keys, from a value. Pointers and interfaces
+// are dereferenced along the way, and a nil pointer resolves to nil, including a nil embedded pointer that a field
+// is promoted through. Returns false if a name can't be found, or if
+// it refers to an unexported field.
+func ResolvePath(value interface{}, path []string) (interface{}, bool) {
+	current := reflect.ValueOf(value)
+
+	for _, name := range path {
+		for current.Kind() == reflect.Ptr || current.Kind() == reflect.Interface {
+			if current.IsNil() {
+				return nil, true
+			}
+			current = current.Elem()
+		}
+
+		switch current.Kind() {
+		case reflect.Struct:
+			field, ok := current.Type().FieldByName(name)
+
+			if !ok || field.PkgPath != "" {
+				return nil, false
+			}
+
+			// Fields promoted through embedded pointers are followed one at a time, since a nil embedded pointer
+			// resolves to nil like any other nil pointer.
+			for i, index := range field.Index {
+				if i > 0 && current.Kind() == reflect.Ptr {
+					if current.IsNil() {
+						return nil, true
+					}
+					current = current.Elem()
+				}
+				current = current.Field(index)
+			}
+		case reflect.Map:
+			if current.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+
+			item := current.MapIndex(reflect.ValueOf(name).Convert(current.Type().Key()))
+
+			if !item.IsValid() {
+				return nil, false
+			}
+
+			current = item
+		default:
+			return nil, false
+		}
+	}
+
+	if !current.IsValid() {
+		return nil, true
+	}
+
+	return current.Interface(), true
+}
+
+// HasPath indicates whether or not a path of field names can be followed from a type. Keys of maps and values of
+// interfaces aren't known before validation, so paths that lead into them are assumed to exist.
+func HasPath(valueType reflect.Type, path []string) bool {
+	for _, name := range path {
+		for valueType.Kind() == reflect.Ptr {
+			valueType = valueType.Elem()
+		}
+
+		switch valueType.Kind() {
+		case reflect.Struct:
+			field, ok := valueType.FieldByName(name)
+
+			if !ok || field.PkgPath != "" {
+				return false
+			}
+
+			valueType = field.Type
+		case reflect.Map, reflect.Interface:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// ArgumentValue converts a value to the types of the arguments that are written in a tag, so that validators can
+// handle resolved references like literals. Numbers, strings and booleans are normalized, so integers become int64
+// and floats float64, nil pointers become nil and slices and arrays become a parser.List. Other values are normalized.
+func ArgumentValue(value interface{}) interface{} {
+	normalized, err := Normalize(value)
+
+	if err != nil || normalized.IsNil {
+		return nil
+	}
+
+	switch typedValue := normalized.Value.(type) {
+	case int64, float64, string, bool:
+		return typedValue
+	}
+
+	switch normalized.OriginalKind {
+	case reflect.Array, reflect.Slice:
+		reflected := reflect.ValueOf(normalized.Value)
+		list := make(parser.List, reflected.Len())
+
+		for i := range list {
+			list[i] = ArgumentValue(reflected.Index(i).Interface())
+		}
+
+		return list
+	}
+
+	return normalized.Value
+}
@@ -0,0 +1,110 @@
+package core_test
+
+import (
+	"fmt"
+	. "github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"testing"
+)
+
+type referenceLimits struct {
+	MaxItems uint8
+	hidden   int
+}
+
+type referenceDummy struct {
+	Limits  *referenceLimits
+	Values  map[string]interface{}
+	Tags    []string
+	Missing *referenceLimits
+}
+
+func TestThatResolvePathFollowsFieldsAndMapKeys(t *testing.T) {
+	dummy := &referenceDummy{
+		Limits: &referenceLimits{MaxItems: 3},
+		Values: map[string]interface{}{"nested": map[string]int{"max": 7}},
+	}
+
+	tests := []struct {
+		path     []string
+		expected interface{}
+		ok       bool
+	}{
+		{[]string{"Limits", "MaxItems"}, uint8(3), true},
+		{[]string{"Values", "nested", "max"}, 7, true},
+		{[]string{"Missing", "MaxItems"}, nil, true},
+		{[]string{"Limits", "hidden"}, nil, false},
+		{[]string{"Limits", "Unknown"}, nil, false},
+		{[]string{"Values", "unknown"}, nil, false},
+		{[]string{"Limits", "MaxItems", "Deeper"}, nil, false},
+	}
+
+	for _, test := range tests {
+		value, ok := ResolvePath(dummy, test.path)
+
+		if ok != test.ok || (ok && value != test.expected) {
+			t.Fatalf("Expected %v (%t) for path %v, but got %v (%t).", test.expected, test.ok, test.path, value, ok)
+		}
+	}
+}
+
+type ReferenceBase struct {
+	Limit int64
+}
+
+type referenceEmbeddingDummy struct {
+	*ReferenceBase
+	Name string
+}
+
+func TestThatResolvePathFollowsFieldsPromotedThroughEmbeddedPointers(t *testing.T) {
+	if value, ok := ResolvePath(&referenceEmbeddingDummy{ReferenceBase: &ReferenceBase{Limit: 5}}, []string{"Limit"}); !ok || value != int64(5) {
+		t.Fatalf("Expected 5 (true), but got %v (%t).", value, ok)
+	}
+
+	if value, ok := ResolvePath(&referenceEmbeddingDummy{}, []string{"Limit"}); !ok || value != nil {
+		t.Fatalf("Expected nil embedded pointer to resolve to nil, but got %v (%t).", value, ok)
+	}
+}
+
+func TestThatHasPathChecksFieldsOfTypes(t *testing.T) {
+	dummyType := reflect.TypeOf(referenceDummy{})
+
+	if !HasPath(dummyType, []string{"Limits", "MaxItems"}) || !HasPath(dummyType, []string{"Values", "anything", "deeper"}) {
+		t.Fatalf("Expected paths to exist.")
+	}
+
+	if HasPath(dummyType, []string{"Limits", "hidden"}) || HasPath(dummyType, []string{"Tags", "Length"}) {
+		t.Fatalf("Didn't expect paths to exist.")
+	}
+}
+
+func TestThatArgumentValueConvertsToArgumentTypes(t *testing.T) {
+	var nilLimits *referenceLimits
+
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{uint8(3), "int64 3"},
+		{int64(-2), "int64 -2"},
+		{float32(1.5), "float64 1.5"},
+		{"abc", "string abc"},
+		{true, "bool true"},
+		{nilLimits, "<nil> <nil>"},
+		{[]int{1, 2}, "parser.List [1, 2]"},
+	}
+
+	for _, test := range tests {
+		value := ArgumentValue(test.value)
+
+		if actual := fmt.Sprintf("%T %v", value, value); actual != test.expected {
+			t.Fatalf("Expected '%s' for %v, but got '%s'.", test.expected, test.value, actual)
+		}
+	}
+
+	if _, ok := ArgumentValue([]string{"a"}).(parser.List); !ok {
+		t.Fatalf("Expected slices to become lists.")
+	}
+}
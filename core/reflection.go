@@ -4,16 +4,42 @@ import (
 	"errors"
 	"github.com/typerandom/validator/core/parser"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 )
 
 type ReflectedField struct {
 	Index        int
 	Parent       *ReflectedField
+	Owner        reflect.Type
 	Name         string
 	DisplayName  *string
 	MethodGroups []parser.Methods
+
+	// Expression is the parsed tag of the field, nil if the tag is empty. MethodGroups are its top level alternatives.
+	Expression parser.Expression
+
+	// Messages are the custom messages of the field, keyed by their scope: an empty string for the whole field,
+	// the name of a method or the 1-based number of a method group.
+	Messages map[string]string
+
+	// Elements are the indexes of the elements of the field's value that the children of the field were found in, if
+	// the value is an array, a slice or a map. Entries of maps are indexed in the order of their sorted keys.
+	Elements []int
+}
+
+// CustomMessage returns the custom message for a failed method in the method group with the specified index.
+// Messages for the method take precedence over those for the method group, which take precedence over the one
+// for the whole field.
+func (this *ReflectedField) CustomMessage(groupIndex int, method string) (string, bool) {
+	for _, scope := range []string{method, strconv.Itoa(groupIndex + 1), ""} {
+		if message, ok := this.Messages[scope]; ok {
+			return message, true
+		}
+	}
+	return "", false
 }
 
 func (this *ReflectedField) GetValue(sourceStruct reflect.Value) interface{} {
@@ -57,6 +83,43 @@ func (this *ReflectedField) FullDisplayName(postfix ...string) string {
 	}, postfix...)
 }
 
+// DisplayNameKey returns the conventional locale key of the display name of the field, i.e. "fields.User.Email".
+func (this *ReflectedField) DisplayNameKey() string {
+	if this.Owner == nil || this.Owner.Name() == "" {
+		return "fields." + this.Name
+	}
+	return "fields." + this.Owner.Name() + "." + this.Name
+}
+
+// LocalizedDisplayName returns the display name of the field in the specified language. The display name from the
+// display name tag is used as a locale key first, then the conventional key of the field is tried. If neither
+// exists, the display name falls back to the one from the tag, or the name of the field.
+func (this *ReflectedField) LocalizedDisplayName(locales *LocaleBundle, language string) string {
+	if this.DisplayName != nil {
+		if translated, _, ok := locales.Lookup(language, *this.DisplayName); ok {
+			return translated
+		}
+	}
+
+	if translated, _, ok := locales.Lookup(language, this.DisplayNameKey()); ok {
+		return translated
+	}
+
+	if this.DisplayName != nil {
+		return *this.DisplayName
+	}
+
+	return this.Name
+}
+
+// FullLocalizedDisplayName works like FullDisplayName, but composes the localized display names of the field and
+// its parents.
+func (this *ReflectedField) FullLocalizedDisplayName(locales *LocaleBundle, language string, postfix ...string) string {
+	return getFullName(this, func(field *ReflectedField) string {
+		return field.LocalizedDisplayName(locales, language)
+	}, postfix...)
+}
+
 func reflectValue(value interface{}) reflect.Type {
 	reflectedValueType := reflect.TypeOf(value)
 
@@ -67,14 +130,108 @@ func reflectValue(value interface{}) reflect.Type {
 	return reflectedValueType
 }
 
-var structFieldCache map[reflect.Type][]*ReflectedField = map[reflect.Type][]*ReflectedField{}
+// lookupTags returns the values of all keys of a struct tag that are equal to the prefix or start with the prefix
+// and a dot, keyed by the remainder of the key, i.e. `message.min:"..."` is returned as "min" for the prefix "message".
+func lookupTags(tag reflect.StructTag, prefix string) map[string]string {
+	values := map[string]string{}
+
+	// Follows the conventional format that reflect.StructTag.Lookup parses.
+	for tag != "" {
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+
+		if tag == "" {
+			break
+		}
+
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+
+		name := string(tag[:i])
+		tag = tag[i+1:]
 
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+
+		if i >= len(tag) {
+			break
+		}
+
+		quotedValue := string(tag[:i+1])
+		tag = tag[i+1:]
+
+		value, err := strconv.Unquote(quotedValue)
+
+		if err != nil {
+			break
+		}
+
+		if name == prefix {
+			values[""] = value
+		} else if strings.HasPrefix(name, prefix+".") {
+			values[name[len(prefix)+1:]] = value
+		}
+	}
+
+	return values
+}
+
+type structFieldCacheKey struct {
+	structType     reflect.Type
+	tagName        string
+	displayNameTag string
+	messageTag     string
+}
+
+var (
+	structFieldCache     = map[structFieldCacheKey][]*ReflectedField{}
+	structFieldCacheLock sync.RWMutex
+)
+
+// GetStructFields returns the exported fields of a struct with their method groups, parsed from the tag with the
+// specified name. The display name of the fields is read from the display name tag, if it's not nil. A tag that can't
+// be parsed is returned as a *ConfigError with the struct type and the name of the field, which wraps the
+// *parser.ParseError.
 func GetStructFields(value interface{}, tagName string, displayNameTag *string) ([]*ReflectedField, error) {
+	return GetStructFieldsWithMessages(value, tagName, displayNameTag, nil)
+}
+
+// GetStructFieldsWithMessages works like GetStructFields, but also reads the custom messages of the fields from the
+// message tag, if it's not nil.
+func GetStructFieldsWithMessages(value interface{}, tagName string, displayNameTag *string, messageTag *string) ([]*ReflectedField, error) {
 	var fields []*ReflectedField
 
 	reflectedType := reflectValue(value)
 
-	if cachedFields, ok := structFieldCache[reflectedType]; ok {
+	cacheKey := structFieldCacheKey{structType: reflectedType, tagName: tagName}
+
+	if displayNameTag != nil {
+		cacheKey.displayNameTag = *displayNameTag
+	}
+
+	if messageTag != nil {
+		cacheKey.messageTag = *messageTag
+	}
+
+	structFieldCacheLock.RLock()
+	cachedFields, ok := structFieldCache[cacheKey]
+	structFieldCacheLock.RUnlock()
+
+	if ok {
 		return cachedFields, nil
 	}
 
@@ -82,10 +239,10 @@ func GetStructFields(value interface{}, tagName string, displayNameTag *string)
 		field := reflectedType.Field(i)
 		if unicode.IsUpper(rune(field.Name[0])) { // only grab exported fields
 			tagValue := field.Tag.Get(tagName)
-			methodGroups, err := parser.Parse(tagValue)
+			expression, err := parser.ParseExpression(tagValue)
 
 			if err != nil {
-				return nil, err
+				return nil, &ConfigError{Type: reflectedType, Field: field.Name, Err: err}
 			}
 
 			var displayName *string
@@ -96,18 +253,31 @@ func GetStructFields(value interface{}, tagName string, displayNameTag *string)
 				}
 			}
 
+			var messages map[string]string
+
+			if messageTag != nil {
+				if tmpMessages := lookupTags(field.Tag, *messageTag); len(tmpMessages) > 0 {
+					messages = tmpMessages
+				}
+			}
+
 			reflectedField := &ReflectedField{
 				Index:        i,
+				Owner:        reflectedType,
 				Name:         field.Name,
 				DisplayName:  displayName,
-				MethodGroups: methodGroups,
+				MethodGroups: parser.Groups(expression),
+				Expression:   expression,
+				Messages:     messages,
 			}
 
 			fields = append(fields, reflectedField)
 		}
 	}
 
-	structFieldCache[reflectedType] = fields
+	structFieldCacheLock.Lock()
+	structFieldCache[cacheKey] = fields
+	structFieldCacheLock.Unlock()
 
 	return fields, nil
 }
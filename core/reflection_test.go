@@ -1,7 +1,10 @@
 package core_test
 
 import (
+	"errors"
 	. "github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
 	"testing"
 )
 
@@ -85,3 +88,113 @@ func TestThatSpecificStructFieldsCanBeReflected(t *testing.T) {
 		}
 	}
 }
+
+func TestThatCustomMessagesOfStructFieldsCanBeReflected(t *testing.T) {
+	type Foo struct {
+		Value string `test:"a,b|c" msg:"Field." msg.b:"Method \"b\"." msg.2:"Group."`
+		Other string `test:"a"`
+	}
+
+	messageTag := "msg"
+	fields, err := GetStructFieldsWithMessages(&Foo{}, "test", nil, &messageTag)
+
+	if err != nil {
+		t.Fatalf("Didn't expect an error, but got '%s'.", err)
+	}
+
+	tests := []struct {
+		group    int
+		method   string
+		expected string
+	}{
+		{0, "a", "Field."},
+		{0, "b", "Method \"b\"."},
+		{1, "c", "Group."},
+		{1, "b", "Method \"b\"."},
+	}
+
+	for _, test := range tests {
+		if message, ok := fields[0].CustomMessage(test.group, test.method); !ok || message != test.expected {
+			t.Fatalf("Expected custom message '%s' for '%s' in group %d, but got '%s'.", test.expected, test.method, test.group, message)
+		}
+	}
+
+	if message, ok := fields[1].CustomMessage(0, "a"); ok {
+		t.Fatalf("Didn't expect a custom message, but got '%s'.", message)
+	}
+
+	if fields, _ := GetStructFields(&Foo{}, "test", nil); fields[0].Messages != nil {
+		t.Fatalf("Didn't expect custom messages without a message tag, but got %v.", fields[0].Messages)
+	}
+}
+
+func TestThatDisplayNamesOfStructFieldsCanBeLocalized(t *testing.T) {
+	type Address struct {
+		Street string `test:"a" label:"labels.street"`
+		Zip    string `test:"a" label:"Zip code"`
+		City   string `test:"a"`
+	}
+
+	type User struct {
+		Home Address `test:"a"`
+	}
+
+	labelTag := "label"
+	userFields, _ := GetStructFields(&User{}, "test", &labelTag)
+	addressFields, _ := GetStructFields(&Address{}, "test", &labelTag)
+
+	if key := addressFields[0].DisplayNameKey(); key != "fields.Address.Street" {
+		t.Fatalf("Expected display name key 'fields.Address.Street', but got '%s'.", key)
+	}
+
+	locales := NewLocaleBundle("en")
+	locales.EnsureLocale("de").Set("fields.User.Home", "Wohnsitz")
+	locales.EnsureLocale("de").Set("labels.street", "Straße")
+	locales.EnsureLocale("de").Set("fields.Address.City", "Stadt")
+
+	home := userFields[0]
+
+	tests := map[*ReflectedField]string{
+		addressFields[0]: "Wohnsitz.Straße",
+		addressFields[1]: "Wohnsitz.Zip code",
+		addressFields[2]: "Wohnsitz.Stadt",
+	}
+
+	for field, expected := range tests {
+		nested := *field
+		nested.Parent = home
+
+		if name := nested.FullLocalizedDisplayName(locales, "de-AT"); name != expected {
+			t.Fatalf("Expected localized display name '%s', but got '%s'.", expected, name)
+		}
+	}
+
+	if name := addressFields[0].LocalizedDisplayName(locales, "en"); name != "labels.street" {
+		t.Fatalf("Expected display name from tag 'labels.street', but got '%s'.", name)
+	}
+}
+
+func TestThatGetStructFieldsLocatesParseErrors(t *testing.T) {
+	type Broken struct {
+		Valid   string `test:"abc"`
+		Invalid string `test:"abc(1,,2)"`
+	}
+
+	_, err := GetStructFields(&Broken{}, "test", nil)
+
+	configErr, ok := err.(*ConfigError)
+
+	if !ok || configErr.Type != reflect.TypeOf(Broken{}) || configErr.Field != "Invalid" {
+		t.Fatalf("Expected config error for field 'Invalid', but got '%v'.", err)
+	}
+
+	var parseErr *parser.ParseError
+
+	if !errors.As(err, &parseErr) || parseErr.Offset != 6 || parseErr.Tag != "abc(1,,2)" {
+		t.Fatalf("Expected wrapped parse error at offset 6, but got '%v'.", err)
+	}
+
+	if expected := "core_test.Broken.Invalid: Unexpected character U+002C ',' at position 7."; err.Error() != expected {
+		t.Fatalf("Expected '%s', but got '%s'.", expected, err)
+	}
+}
@@ -1,9 +1,5 @@
 package core
 
-import (
-	"errors"
-)
-
 type ValidatorFn func(context ValidatorContext, args []interface{}) error
 
 type ValidatorRegistry map[string]ValidatorFn
@@ -20,7 +16,7 @@ func (r ValidatorRegistry) Get(name string) (ValidatorFn, error) {
 	validator, ok := r[name]
 
 	if !ok {
-		return nil, errors.New("Validator '" + name + "' is not registered.")
+		return nil, NewConfigError(NewMessage("validator.notRegistered", "Validator '{name}' is not registered.", nil, Params{"name": name}))
 	}
 
 	return validator, nil
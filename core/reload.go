@@ -0,0 +1,187 @@
+package core
+
+import (
+	"hash/fnv"
+	"io/fs"
+	"path"
+	"sync"
+	"time"
+)
+
+// Reload rebuilds the messages of the bundle and atomically swaps them in. The load function fills a new, empty
+// bundle with the same default language and fallbacks, i.e. by registering the built-in messages and loading
+// translation files. If it fails, the bundle is left untouched. Validations that are in progress are not paused,
+// every message that they resolve comes either from the old or from the new messages.
+// Locales that were handed out before, i.e. by Locale, remain valid and receive the new messages.
+func (this *LocaleBundle) Reload(load func(bundle *LocaleBundle) error) error {
+	this.lock.RLock()
+	fresh := NewLocaleBundle(this.defaultTag)
+
+	for tag, fallback := range this.fallbacks {
+		fresh.fallbacks[tag] = fallback
+	}
+	this.lock.RUnlock()
+
+	if err := load(fresh); err != nil {
+		return err
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	for tag, locale := range this.locales {
+		if freshLocale, ok := fresh.locales[tag]; ok {
+			locale.replace(freshLocale.snapshot())
+		} else {
+			locale.replace(map[string]string{})
+		}
+	}
+
+	for tag, freshLocale := range fresh.locales {
+		if _, ok := this.locales[tag]; !ok {
+			this.locales[tag] = freshLocale
+		}
+	}
+
+	this.fallbacks = fresh.fallbacks
+
+	return nil
+}
+
+// ReloadFS reloads the bundle from a directory of locale files, see LoadFS. The base function registers the messages
+// that the files are loaded on top of, i.e. validators.RegisterDefaultLocales, and can be nil.
+func (this *LocaleBundle) ReloadFS(fsys fs.FS, dir string, base func(bundle *LocaleBundle)) error {
+	return this.Reload(func(bundle *LocaleBundle) error {
+		if base != nil {
+			base(bundle)
+		}
+		return bundle.LoadFS(fsys, dir)
+	})
+}
+
+// LocaleWatcher polls a directory of locale files and reloads a bundle whenever they change.
+type LocaleWatcher struct {
+	bundle *LocaleBundle
+	fsys   fs.FS
+	dir    string
+	base   func(bundle *LocaleBundle)
+
+	lock        sync.Mutex
+	fingerprint uint64
+	err         error
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// WatchFS starts polling a directory of locale files at the specified interval and reloads the bundle with ReloadFS
+// whenever the files change. The bundle is expected to be loaded from the directory already.
+func (this *LocaleBundle) WatchFS(fsys fs.FS, dir string, base func(bundle *LocaleBundle), interval time.Duration) *LocaleWatcher {
+	watcher := &LocaleWatcher{
+		bundle: this,
+		fsys:   fsys,
+		dir:    dir,
+		base:   base,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	watcher.fingerprint, _ = fingerprintLocales(fsys, dir)
+
+	go func() {
+		defer close(watcher.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				watcher.Check()
+			case <-watcher.stop:
+				return
+			}
+		}
+	}()
+
+	return watcher
+}
+
+// Check reloads the bundle if the locale files have changed since the last reload. It can be called to reload
+// outside of the polling interval, i.e. on SIGHUP. Returns whether or not the bundle was reloaded.
+func (this *LocaleWatcher) Check() (bool, error) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	fingerprint, err := fingerprintLocales(this.fsys, this.dir)
+
+	if err == nil && fingerprint == this.fingerprint {
+		return false, nil
+	}
+
+	if err == nil {
+		err = this.bundle.ReloadFS(this.fsys, this.dir, this.base)
+	}
+
+	this.err = err
+
+	if err != nil {
+		return false, err
+	}
+
+	this.fingerprint = fingerprint
+
+	return true, nil
+}
+
+// Err returns the error of the last reload, if it failed. The bundle keeps its previous messages in that case.
+func (this *LocaleWatcher) Err() error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	return this.err
+}
+
+// Stop stops polling and waits for a reload in progress to finish.
+func (this *LocaleWatcher) Stop() {
+	close(this.stop)
+	<-this.done
+}
+
+// fingerprintLocales hashes the names and contents of the locale files in a directory, including those in the
+// directories of languages.
+func fingerprintLocales(fsys fs.FS, dir string) (uint64, error) {
+	hash := fnv.New64a()
+
+	err := fs.WalkDir(fsys, dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if name != dir && path.Dir(name) != dir {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if !isLocaleFile(name) {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+
+		if err != nil {
+			return err
+		}
+
+		hash.Write([]byte(name))
+		hash.Write([]byte{0})
+		hash.Write(data)
+		hash.Write([]byte{0})
+
+		return nil
+	})
+
+	return hash.Sum64(), err
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"github.com/typerandom/validator/core/parser"
+)
+
+// ArgKind is the kind of a validator argument, as written in a validate tag.
+type ArgKind int
+
+const (
+	AnyArg ArgKind = iota
+	NumberArg
+	StringArg
+	BoolArg
+
+	// ListArg is combined with another kind to accept either an argument of that kind or a list of them,
+	// i.e. StringArg | ListArg.
+	ListArg ArgKind = 1 << 3
+)
+
+func (this ArgKind) String() string {
+	if this&ListArg != 0 {
+		return (this &^ ListArg).String() + " or list"
+	}
+
+	switch this {
+	case NumberArg:
+		return "number"
+	case StringArg:
+		return "string"
+	case BoolArg:
+		return "boolean"
+	default:
+		return "any"
+	}
+}
+
+func (this ArgKind) accepts(arg interface{}) bool {
+	// References are resolved at validation time, the validator checks the type of the value then.
+	if _, ok := arg.(*parser.Reference); ok {
+		return true
+	}
+
+	if list, ok := arg.(parser.List); ok {
+		if this == AnyArg {
+			return true
+		}
+
+		if this&ListArg == 0 {
+			return false
+		}
+
+		for _, value := range list {
+			if !(this &^ ListArg).accepts(value) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	this = this &^ ListArg
+
+	if IsNumber(arg) {
+		return this == AnyArg || this == NumberArg
+	}
+
+	switch arg.(type) {
+	case string:
+		return this == AnyArg || this == StringArg
+	case bool:
+		return this == AnyArg || this == BoolArg
+	default:
+		return this == AnyArg
+	}
+}
+
+// Signature describes the arguments that a validator accepts. It's used by CheckSyntax to find invalid arguments
+// before any value is validated.
+type Signature struct {
+	// Args contains the kinds of the positional arguments.
+	Args []ArgKind
+
+	// Required is the number of arguments that must be given. Arguments in Args after those are optional.
+	Required int
+
+	// Variadic allows any number of additional arguments of the last kind in Args.
+	Variadic bool
+
+	// Keywords contains the kinds of the keyword arguments by their names. Other keyword arguments are rejected.
+	Keywords map[string]ArgKind
+
+	// LocaleKeys are the keys of the messages that the validator creates errors with. See CheckLocaleKeys.
+	LocaleKeys []string
+}
+
+// NewSignature creates a signature where all of the specified arguments are required.
+func NewSignature(args ...ArgKind) *Signature {
+	return &Signature{
+		Args:     args,
+		Required: len(args),
+	}
+}
+
+// WithLocaleKeys declares the keys of the messages that the validator creates errors with and returns the signature.
+func (this *Signature) WithLocaleKeys(keys ...string) *Signature {
+	this.LocaleKeys = append(this.LocaleKeys, keys...)
+	return this
+}
+
+// countError creates the error for a wrong number of arguments.
+func (this *Signature) countError(validatorName string, count int) error {
+	params := Params{"name": validatorName, "count": count, "required": this.Required, "max": len(this.Args)}
+
+	switch {
+	case this.Variadic:
+		return NewMessage("signature.expectsAtLeast", "Validator '{name}' expects at least {required, plural, one {# argument} other {# arguments}}, but got {count}.", nil, params)
+	case len(this.Args) == 0:
+		return NewMessage("signature.expectsNone", "Validator '{name}' expects no arguments, but got {count}.", nil, params)
+	case this.Required == len(this.Args):
+		return NewMessage("signature.expectsExactly", "Validator '{name}' expects {required, plural, one {# argument} other {# arguments}}, but got {count}.", nil, params)
+	default:
+		return NewMessage("signature.expectsBetween", "Validator '{name}' expects between {required} and {max, plural, one {# argument} other {# arguments}}, but got {count}.", nil, params)
+	}
+}
+
+// Check checks that the arguments match the signature of the validator with the specified name.
+func (this *Signature) Check(validatorName string, args []interface{}) error {
+	if len(args) < this.Required || (!this.Variadic && len(args) > len(this.Args)) {
+		return this.countError(validatorName, len(args))
+	}
+
+	for i, arg := range args {
+		kind := AnyArg
+
+		if i < len(this.Args) {
+			kind = this.Args[i]
+		} else if len(this.Args) > 0 {
+			kind = this.Args[len(this.Args)-1]
+		}
+
+		if !kind.accepts(arg) {
+			return NewMessage("signature.invalidArgumentType", "Validator '{name}' requires argument {position} to be of type {type}.", nil, Params{"name": validatorName, "position": i + 1, "type": kind.String()})
+		}
+	}
+
+	return nil
+}
+
+// CheckKeywords checks that the keyword arguments match the signature of the validator with the specified name.
+func (this *Signature) CheckKeywords(validatorName string, keywords parser.Keywords) error {
+	for _, keyword := range keywords {
+		kind, ok := this.Keywords[keyword.Name]
+
+		if !ok {
+			return NewMessage("signature.unknownKeyword", "Validator '{name}' has no keyword argument '{keyword}'.", nil, Params{"name": validatorName, "keyword": keyword.Name})
+		}
+
+		if !kind.accepts(keyword.Value) {
+			return NewMessage("signature.invalidKeywordType", "Validator '{name}' requires keyword argument '{keyword}' to be of type {type}.", nil, Params{"name": validatorName, "keyword": keyword.Name, "type": kind.String()})
+		}
+	}
+
+	return nil
+}
+
+type SignatureRegistry map[string]*Signature
+
+func NewSignatureRegistry() SignatureRegistry {
+	return make(SignatureRegistry)
+}
+
+func (r SignatureRegistry) Register(name string, signature *Signature) {
+	r[name] = signature
+}
+
+// Get returns the signature of the validator with the specified name, or nil if it hasn't declared any.
+func (r SignatureRegistry) Get(name string) *Signature {
+	return r[name]
+}
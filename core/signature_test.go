@@ -0,0 +1,88 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"testing"
+)
+
+func testThatSignatureCheckResultsIn(t *testing.T, signature *Signature, args []interface{}, expectedErr string) {
+	err := signature.Check("test", args)
+
+	if len(expectedErr) == 0 {
+		if err != nil {
+			t.Fatalf("Didn't expect error for %v, but got '%s'.", args, err)
+		}
+		return
+	}
+
+	if err == nil || err.Error() != expectedErr {
+		t.Fatalf("Expected error '%s' for %v, but got '%v'.", expectedErr, args, err)
+	}
+}
+
+func TestThatSignatureChecksArgumentCount(t *testing.T) {
+	testThatSignatureCheckResultsIn(t, NewSignature(), []interface{}{}, "")
+	testThatSignatureCheckResultsIn(t, NewSignature(), []interface{}{1.0}, "Validator 'test' expects no arguments, but got 1.")
+	testThatSignatureCheckResultsIn(t, NewSignature(NumberArg), []interface{}{}, "Validator 'test' expects 1 argument, but got 0.")
+	testThatSignatureCheckResultsIn(t, NewSignature(NumberArg, NumberArg), []interface{}{1.0}, "Validator 'test' expects 2 arguments, but got 1.")
+
+	optional := &Signature{Args: []ArgKind{StringArg, NumberArg}, Required: 1}
+
+	testThatSignatureCheckResultsIn(t, optional, []interface{}{"a"}, "")
+	testThatSignatureCheckResultsIn(t, optional, []interface{}{"a", 1.0, 2.0}, "Validator 'test' expects between 1 and 2 arguments, but got 3.")
+
+	variadic := &Signature{Args: []ArgKind{StringArg}, Required: 1, Variadic: true}
+
+	testThatSignatureCheckResultsIn(t, variadic, []interface{}{"a", "b", "c"}, "")
+	testThatSignatureCheckResultsIn(t, variadic, []interface{}{}, "Validator 'test' expects at least 1 argument, but got 0.")
+}
+
+func TestThatSignatureChecksArgumentKinds(t *testing.T) {
+	testThatSignatureCheckResultsIn(t, NewSignature(NumberArg), []interface{}{"a"}, "Validator 'test' requires argument 1 to be of type number.")
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg, BoolArg), []interface{}{"a", 1.0}, "Validator 'test' requires argument 2 to be of type boolean.")
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg), []interface{}{nil}, "Validator 'test' requires argument 1 to be of type string.")
+	testThatSignatureCheckResultsIn(t, NewSignature(AnyArg), []interface{}{nil}, "")
+
+	variadic := &Signature{Args: []ArgKind{StringArg, NumberArg}, Variadic: true}
+
+	testThatSignatureCheckResultsIn(t, variadic, []interface{}{"a", 1.0, 2.0}, "")
+	testThatSignatureCheckResultsIn(t, variadic, []interface{}{"a", 1.0, "b"}, "Validator 'test' requires argument 3 to be of type number.")
+}
+
+func TestThatSignatureChecksListArguments(t *testing.T) {
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg|ListArg), []interface{}{"a"}, "")
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg|ListArg), []interface{}{parser.List{"a", "b"}}, "")
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg|ListArg), []interface{}{parser.List{}}, "")
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg|ListArg), []interface{}{parser.List{"a", 1.0}}, "Validator 'test' requires argument 1 to be of type string or list.")
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg|ListArg), []interface{}{1.0}, "Validator 'test' requires argument 1 to be of type string or list.")
+	testThatSignatureCheckResultsIn(t, NewSignature(StringArg), []interface{}{parser.List{"a"}}, "Validator 'test' requires argument 1 to be of type string.")
+	testThatSignatureCheckResultsIn(t, NewSignature(AnyArg), []interface{}{parser.List{"a", 1.0, true}}, "")
+}
+
+func TestThatSignatureChecksKeywordArguments(t *testing.T) {
+	signature := &Signature{Keywords: map[string]ArgKind{"layout": StringArg, "max": NumberArg}}
+
+	tests := []struct {
+		keywords    parser.Keywords
+		expectedErr string
+	}{
+		{nil, ""},
+		{parser.Keywords{{Name: "layout", Value: "2006"}, {Name: "max", Value: int64(3)}}, ""},
+		{parser.Keywords{{Name: "max", Value: parser.NewReference("Max")}}, ""},
+		{parser.Keywords{{Name: "min", Value: int64(3)}}, "Validator 'test' has no keyword argument 'min'."},
+		{parser.Keywords{{Name: "max", Value: "3"}}, "Validator 'test' requires keyword argument 'max' to be of type number."},
+	}
+
+	for _, test := range tests {
+		err := signature.CheckKeywords("test", test.keywords)
+
+		if (err == nil && test.expectedErr != "") || (err != nil && err.Error() != test.expectedErr) {
+			t.Fatalf("Expected error '%s' for %v, but got '%v'.", test.expectedErr, test.keywords, err)
+		}
+	}
+
+	if err := NewSignature().CheckKeywords("test", parser.Keywords{{Name: "a", Value: nil}}); err == nil {
+		t.Fatalf("Expected signatures without keywords to reject keyword arguments.")
+	}
+}
@@ -13,6 +13,8 @@ type testContext struct {
 	isNil        bool
 
 	field *ReflectedField
+
+	arguments *Arguments
 }
 
 func NewTestContext(value interface{}) *testContext {
@@ -63,6 +65,19 @@ func (this *testContext) Field() *ReflectedField {
 	return this.field
 }
 
+// SetArguments sets the arguments that Arguments returns, which are empty by default. The positional arguments aren't
+// passed to the validator by it.
+func (this *testContext) SetArguments(arguments *Arguments) {
+	this.arguments = arguments
+}
+
+func (this *testContext) Arguments() *Arguments {
+	if this.arguments == nil {
+		return NewArguments(nil, nil)
+	}
+	return this.arguments
+}
+
 func (this *testContext) OriginalKind() reflect.Kind {
 	return this.originalKind
 }
@@ -70,3 +85,7 @@ func (this *testContext) OriginalKind() reflect.Kind {
 func (this *testContext) NewError(localeKey string, args ...interface{}) error {
 	return errors.New(localeKey)
 }
+
+func (this *testContext) NewConfigError(localeKey string, args ...interface{}) error {
+	return NewConfigError(this.NewError(localeKey, args...))
+}
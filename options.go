@@ -0,0 +1,45 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+// ValidateOption configures a single call to Validate.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	language string
+	values   map[string]interface{}
+}
+
+// WithLanguage makes a single call to Validate produce its error messages in the language with the specified
+// BCP 47 tag, instead of the language of the validator. Use LocaleBundle.Negotiate to pick the language from an
+// HTTP Accept-Language header.
+func WithLanguage(tag string) ValidateOption {
+	return func(options *validateOptions) {
+		options.language = core.NormalizeTag(tag)
+	}
+}
+
+// WithValue makes a value available to the tags of a single call to Validate, where "$ctx.<key>" refers to it.
+// I.e. WithValue("tenantMax", 50) for `validate:"max($ctx.tenantMax)"`.
+func WithValue(key string, value interface{}) ValidateOption {
+	return func(options *validateOptions) {
+		if options.values == nil {
+			options.values = map[string]interface{}{}
+		}
+		options.values[key] = value
+	}
+}
+
+func (this *validator) newValidateOptions(options []ValidateOption) *validateOptions {
+	validateOptions := &validateOptions{
+		language: this.language,
+	}
+
+	for _, option := range options {
+		option(validateOptions)
+	}
+
+	return validateOptions
+}
@@ -0,0 +1,215 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+func (this *validator) CheckSyntax(value interface{}) error {
+	valueType, ok := value.(reflect.Type)
+
+	if !ok {
+		valueType = reflect.TypeOf(value)
+	}
+
+	var errs core.ConfigErrors
+
+	this.checkType(valueType, map[reflect.Type]bool{}, &errs)
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// checkType checks the struct types that can be reached from valueType, the same way that the walker would reach them.
+func (this *validator) checkType(valueType reflect.Type, visited map[reflect.Type]bool, errs *core.ConfigErrors) {
+	for valueType != nil && isContainerKind(valueType.Kind()) {
+		valueType = valueType.Elem()
+	}
+
+	if valueType == nil || valueType.Kind() != reflect.Struct || visited[valueType] {
+		return
+	}
+
+	visited[valueType] = true
+
+	for _, err := range this.checkStruct(valueType) {
+		*errs = append(*errs, this.locales.Translated(err, this.language).(*core.ConfigError))
+	}
+
+	fields, err := this.getStructFields(valueType)
+
+	if err != nil {
+		return
+	}
+
+	for _, field := range fields {
+		this.checkType(valueType.Field(field.Index).Type, visited, errs)
+	}
+}
+
+func isContainerKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Ptr, reflect.Array, reflect.Slice, reflect.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkStruct checks the validate tags of the fields of a struct type, without descending into nested types.
+func (this *validator) checkStruct(structType reflect.Type) core.ConfigErrors {
+	var errs core.ConfigErrors
+
+	fields, err := this.getStructFields(structType)
+
+	if err != nil {
+		return append(errs, locateConfigError(err, structType, ""))
+	}
+
+	for _, field := range fields {
+		for _, methods := range field.MethodGroups {
+			for _, method := range methods {
+				if _, err := this.registry.Get(method.Name); err != nil {
+					errs = append(errs, locateConfigError(err, structType, field.Name))
+					continue
+				}
+
+				if signature := this.signatures.Get(method.Name); signature != nil {
+					if err := signature.Check(method.Name, method.Arguments); err != nil {
+						errs = append(errs, locateConfigError(err, structType, field.Name))
+						continue
+					}
+				}
+
+				if err := this.checkKeywords(method); err != nil {
+					errs = append(errs, locateConfigError(err, structType, field.Name))
+				}
+
+				// References to fields of the struct can be checked up front, those to the root or to values of the
+				// call to Validate can't.
+				for _, reference := range method.References() {
+					if reference.Scope == "" && !core.HasPath(structType, reference.Path) {
+						err := core.NewMessage("reference.cannotResolve", "Reference '{reference}' can't be resolved.", nil, core.Params{"reference": reference.String()})
+						errs = append(errs, locateConfigError(err, structType, field.Name))
+					}
+				}
+			}
+		}
+
+		for _, err := range this.checkMessageScopes(field) {
+			errs = append(errs, locateConfigError(err, structType, field.Name))
+		}
+	}
+
+	return errs
+}
+
+// checkKeywords checks the keyword arguments of a method against the signature of its validator. Validators without
+// a signature don't accept any keyword arguments.
+func (this *validator) checkKeywords(method *parser.Method) error {
+	if len(method.Keywords) == 0 {
+		return nil
+	}
+
+	signature := this.signatures.Get(method.Name)
+
+	if signature == nil {
+		signature = core.NewSignature()
+	}
+
+	return signature.CheckKeywords(method.Name, method.Keywords)
+}
+
+// checkMessageScopes verifies that the custom messages of a field are scoped to methods or method groups that the
+// field has.
+func (this *validator) checkMessageScopes(field *core.ReflectedField) []error {
+	var errs []error
+
+	scopes := make([]string, 0, len(field.Messages))
+
+	for scope := range field.Messages {
+		scopes = append(scopes, scope)
+	}
+
+	sort.Strings(scopes)
+
+	for _, scope := range scopes {
+		if scope == "" {
+			continue
+		}
+
+		if number, err := strconv.Atoi(scope); err == nil && number >= 1 && number <= len(field.MethodGroups) {
+			continue
+		}
+
+		if hasMethod(field, scope) {
+			continue
+		}
+
+		errs = append(errs, core.NewMessage("syntax.unknownMessageScope", "Message '{tag}.{scope}' doesn't match a method or method group of the field.", nil, core.Params{"tag": *this.messageTag, "scope": scope}))
+	}
+
+	return errs
+}
+
+func hasMethod(field *core.ReflectedField, name string) bool {
+	for _, methods := range field.MethodGroups {
+		for _, method := range methods {
+			if method.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (this *validator) CheckLocales() error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	issues := this.locales.CheckCoverage()
+	issues = append(issues, core.CheckLocaleKeys(this.locales, this.signatures)...)
+
+	if len(issues) > 0 {
+		return issues
+	}
+
+	return nil
+}
+
+// checkedStruct returns the result of checkStruct, which is only run once per struct type. The errors are shared
+// between calls and untranslated, so callers translate copies of them.
+func (this *validator) checkedStruct(structType reflect.Type) core.ConfigErrors {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	errs, ok := this.checkedStructs[structType]
+
+	if !ok {
+		errs = this.checkStruct(structType)
+		this.checkedStructs[structType] = errs
+	}
+
+	return errs
+}
+
+func locateConfigError(err error, structType reflect.Type, fieldName string) *core.ConfigError {
+	if configErr, ok := err.(*core.ConfigError); ok {
+		if fieldName == "" {
+			fieldName = configErr.Field
+		}
+		err = configErr.Err
+	}
+
+	return &core.ConfigError{
+		Type:  structType,
+		Field: fieldName,
+		Err:   err,
+	}
+}
@@ -0,0 +1,223 @@
+package validator_test
+
+import (
+	"errors"
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"testing"
+)
+
+func TestThatCheckSyntaxSucceedsForValidStruct(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"min(1),max(2)|empty"`
+	}
+
+	if err := New().CheckSyntax(&Dummy{}); err != nil {
+		t.Fatalf("Didn't expect error, but got '%s'.", err)
+	}
+}
+
+func TestThatCheckSyntaxFailsForUnknownValidators(t *testing.T) {
+	type Dummy struct {
+		First  string `validate:"unknown_a"`
+		Second string `validate:"min(1)|unknown_b"`
+	}
+
+	err := New().CheckSyntax(&Dummy{})
+
+	errs, ok := err.(core.ConfigErrors)
+
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected 2 config errors, but got '%v'.", err)
+	}
+
+	if expectedErr := "validator_test.Dummy.Second: Validator 'unknown_b' is not registered."; errs[1].Error() != expectedErr {
+		t.Fatalf("Expected '%s', but got '%s'.", expectedErr, errs[1])
+	}
+}
+
+type syntaxAddress struct {
+	Street string `validate:"min(1,2)"`
+	Zip    string `validate:"regexp"`
+}
+
+type syntaxUser struct {
+	Name      string `validate:"max(´abc´)"`
+	Home      *syntaxAddress
+	Previous  []syntaxAddress
+	ByCountry map[string]*syntaxAddress
+	Friends   []*syntaxUser
+	Tags      []string `validate:"min(1)"`
+}
+
+func TestThatCheckSyntaxChecksNestedStructsAndArguments(t *testing.T) {
+	expectedErrs := []string{
+		"validator_test.syntaxUser.Name: Validator 'max' requires argument 1 to be of type number.",
+		"validator_test.syntaxAddress.Street: Validator 'min' expects 1 argument, but got 2.",
+		"validator_test.syntaxAddress.Zip: Validator 'regexp' expects 1 argument, but got 0.",
+	}
+
+	for _, value := range []interface{}{&syntaxUser{}, reflect.TypeOf(syntaxUser{}), []map[int]syntaxUser{}} {
+		errs, ok := New().CheckSyntax(value).(core.ConfigErrors)
+
+		if !ok || len(errs) != len(expectedErrs) {
+			t.Fatalf("Expected %d config errors for %T, but got '%v'.", len(expectedErrs), value, errs)
+		}
+
+		for i, expectedErr := range expectedErrs {
+			if errs[i].Error() != expectedErr {
+				t.Fatalf("Expected '%s', but got '%s'.", expectedErr, errs[i])
+			}
+		}
+	}
+}
+
+func TestThatCheckSyntaxUsesRegisteredSignatures(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"custom(1, ´a´)"`
+	}
+
+	validator := New()
+
+	validator.Register("custom", func(ctx core.ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	if err := validator.CheckSyntax(&Dummy{}); err != nil {
+		t.Fatalf("Didn't expect error without signature, but got '%s'.", err)
+	}
+
+	validator.RegisterSignature("custom", &core.Signature{Args: []core.ArgKind{core.NumberArg, core.NumberArg}, Required: 1})
+
+	if expectedErr := "validator_test.Dummy.Value: Validator 'custom' requires argument 2 to be of type number."; validator.CheckSyntax(&Dummy{}).Error() != expectedErr {
+		t.Fatalf("Expected '%s', but got '%s'.", expectedErr, validator.CheckSyntax(&Dummy{}))
+	}
+}
+
+func TestThatGlobalCheckSyntaxChecksValidateTags(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"min"`
+	}
+
+	if err := CheckSyntax(&Dummy{}); err == nil {
+		t.Fatal("Expected error, but didn't get any.")
+	}
+
+	if err := CheckSyntax(nil); err != nil {
+		t.Fatalf("Didn't expect error for nil, but got '%s'.", err)
+	}
+}
+
+func TestThatCheckSyntaxVerifiesScopesOfCustomMessages(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"min(1)|empty" message:"Field." message.min:"Method." message.2:"Group." message.3:"Group." message.max:"Method."`
+	}
+
+	err := New().CheckSyntax(&Dummy{})
+
+	errs, ok := err.(core.ConfigErrors)
+
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected 2 config errors, but got '%v'.", err)
+	}
+
+	if expectedErr := "validator_test.Dummy.Value: Message 'message.3' doesn't match a method or method group of the field."; errs[0].Error() != expectedErr {
+		t.Fatalf("Expected '%s', but got '%s'.", expectedErr, errs[0])
+	}
+
+	if expectedErr := "validator_test.Dummy.Value: Message 'message.max' doesn't match a method or method group of the field."; errs[1].Error() != expectedErr {
+		t.Fatalf("Expected '%s', but got '%s'.", expectedErr, errs[1])
+	}
+}
+
+func TestThatCheckLocalesSucceedsForDefaultLocales(t *testing.T) {
+	if err := New().CheckLocales(); err != nil {
+		t.Fatalf("Didn't expect locale issues, but got:\n%s", err)
+	}
+}
+
+func TestThatCheckLocalesReportsIssues(t *testing.T) {
+	validator := New()
+
+	validator.Locales().EnsureLocale("de").Set("min.cannotBeLessThan", "{field} muss mindestens {max} sein.")
+	validator.Locales().EnsureLocale("de").Set("custom.key", "Extra.")
+	validator.Register("positive", func(context core.ValidatorContext, args []interface{}) error {
+		return context.NewError("positive.mustBePositive")
+	})
+	validator.RegisterSignature("positive", core.NewSignature().WithLocaleKeys("positive.mustBePositive"))
+
+	err := validator.CheckLocales()
+
+	issues, ok := err.(core.LocaleIssues)
+
+	if !ok {
+		t.Fatalf("Expected locale issues, but got '%v'.", err)
+	}
+
+	expected := []string{
+		"de: extra key 'custom.key' that the reference locale doesn't have.",
+		"de: key 'min.cannotBeLessThan' has placeholders [field max], but the reference message has [field min].",
+	}
+
+	for _, tag := range validator.Locales().Tags() {
+		expected = append(expected, tag+": missing key 'positive.mustBePositive' used by validator 'positive'.")
+	}
+
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %d issues, but got:\n%s", len(expected), issues)
+	}
+
+	for i, issue := range issues {
+		if issue.Error() != expected[i] {
+			t.Fatalf("Expected issue '%s', but got '%s'.", expected[i], issue)
+		}
+	}
+}
+
+func TestThatCheckSyntaxFailsForUnknownFieldReferences(t *testing.T) {
+	type Limits struct {
+		MaxItems int
+	}
+
+	type Dummy struct {
+		Limits   *Limits
+		Extra    map[string]int
+		Password string
+		Confirm  string `validate:"equal($Password),max($Limits.MaxItems),min($Extra.anything),max($root.Unknown),min($ctx.unknown)"`
+		Other    string `validate:"equal([$Password, $Pasword])"`
+	}
+
+	err := New().CheckSyntax(&Dummy{})
+
+	errs, ok := err.(core.ConfigErrors)
+
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Expected 1 config error, but got '%v'.", err)
+	}
+
+	if expectedErr := "validator_test.Dummy.Other: Reference '$Pasword' can't be resolved."; errs[0].Error() != expectedErr {
+		t.Fatalf("Expected '%s', but got '%s'.", expectedErr, errs[0])
+	}
+}
+
+func TestThatCheckSyntaxLocatesParseErrors(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"min(1),,max(2)"`
+	}
+
+	err := New().CheckSyntax(&Dummy{})
+
+	errs, ok := err.(core.ConfigErrors)
+
+	if !ok || len(errs) != 1 || errs[0].Field != "Value" || errs[0].Error() != "validator_test.Dummy.Value: Unexpected character U+002C ',' at position 7." {
+		t.Fatalf("Expected a parse error of field 'Value', but got '%v'.", err)
+	}
+
+	var parseErr *parser.ParseError
+
+	if !errors.As(errs[0], &parseErr) || parseErr.Snippet() != "min(1),,max(2)\n      ^" {
+		t.Fatalf("Expected a parse error with snippet, but got '%v'.", errs[0])
+	}
+}
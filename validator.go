@@ -3,23 +3,93 @@ package validator
 
 import (
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	"github.com/typerandom/validator/validators"
+	"reflect"
 	"sync"
 )
 
+// ConfigErrorPolicy determines how mistakes in the validation setup, such as unknown validators or invalid
+// validator arguments, are handled.
+type ConfigErrorPolicy int
+
+const (
+	// ReportConfigErrors adds an error for each misconfigured field and continues with the remaining fields.
+	ReportConfigErrors ConfigErrorPolicy = iota
+
+	// PanicOnConfigErrors panics with a *core.Error the first time a misconfigured field is validated.
+	PanicOnConfigErrors
+
+	// CheckSyntaxBeforeValidate runs CheckSyntax on each struct type the first time it's validated. Struct types
+	// that fail the check aren't validated at all, instead their configuration errors are reported.
+	CheckSyntaxBeforeValidate
+)
+
 type Validator interface {
 	// The tag that is used for the field's display name.
 	// Default: Empty string that defaults to the field name.
 	SetDisplayNameTag(name string)
 
-	// Locale retrieves the locale for this validator.
+	// SetMessageTag sets the tag that declares custom messages of a field. The tag `message:"..."` replaces the
+	// messages of all methods of the field, `message.<method>:"..."` those of a method and `message.<n>:"..."`
+	// those of the n-th method group. The value is a locale key, or a message template if there's no such key.
+	// Default: "message". An empty string disables custom messages.
+	SetMessageTag(name string)
+
+	// SetMapKeyComparator sets the function used to order map keys that aren't numbers, strings or booleans,
+	// so that the errors of maps are always reported in the same order. Maps with channel keys, or with struct or
+	// array keys that contain pointers or channels, need one to be ordered the same from run to run.
+	// Default: Nil, which orders pointers by the values they point to and other keys by their formatted representation.
+	SetMapKeyComparator(less core.MapKeyLessFn)
+
+	// SetRepanic controls whether or not panics inside of validators are propagated after they've been recovered.
+	// When enabled, the panic is re-raised with a *core.Error that wraps a *core.PanicError. Useful for debugging.
+	// Default: False, which converts the panic into a *core.Error that is added to the error list.
+	SetRepanic(repanic bool)
+
+	// SetConfigErrorPolicy sets how mistakes in the validation setup are handled.
+	// Default: ReportConfigErrors.
+	SetConfigErrorPolicy(policy ConfigErrorPolicy)
+
+	// Locale retrieves the locale of the current language of this validator.
 	Locale() *core.Locale
 
+	// Locales retrieves the bundle with the locales of all languages of this validator.
+	Locales() *core.LocaleBundle
+
+	// SetLanguage sets the BCP 47 tag of the language that error messages are produced in.
+	// Messages that are missing in the language are resolved through its fallback chain in the locale bundle.
+	// Default: "en".
+	SetLanguage(tag string)
+
 	// Register registers a validator by name.
 	Register(name string, validator core.ValidatorFn)
 
+	// RegisterAlias registers an expression that the tags can call by name, i.e. RegisterAlias("username",
+	// "min(3),max(32),lowercase") for `validate:"username"`. Parameters are declared after the name and referenced as
+	// "$<name>" in the expression, i.e. RegisterAlias("slug(lo, hi)", "min($lo),max($hi)") for `validate:"slug(3, 32)"`.
+	// Aliases are expanded when the tags are parsed, they can call other aliases but not themselves. Aliases take
+	// precedence over validators of the same name.
+	// Returns an error if the alias can't be parsed or if it closes a cycle of aliases.
+	RegisterAlias(name string, expression string) error
+
+	// RegisterSignature declares the arguments that a registered validator accepts, so that CheckSyntax can verify them.
+	RegisterSignature(name string, signature *core.Signature)
+
 	// Validate validates fields of a structure, or structures of a map, slice or array.
-	Validate(value interface{}) core.ErrorList
+	// Options only apply to this call, i.e. WithLanguage.
+	Validate(value interface{}, options ...ValidateOption) core.ErrorList
+
+	// CheckSyntax checks the validate tags of a structure and of all structures nested in its fields, slices, arrays,
+	// maps and pointers. Verifies that the validators used are registered and that their arguments match their
+	// signatures. The value can either be a value of the type to check, or a reflect.Type.
+	// Returns core.ConfigErrors with all of the problems found, if there are any.
+	CheckSyntax(value interface{}) error
+
+	// CheckLocales compares the locales of all languages to the locale of the default language and verifies that the
+	// locale keys declared in the signatures of the registered validators exist in every language.
+	// Returns core.LocaleIssues with all of the problems found, if there are any.
+	CheckLocales() error
 
 	// Copy deep copies the validator and returns a new instance.
 	Copy() Validator
@@ -28,20 +98,41 @@ type Validator interface {
 // Validator represents a validator with it's own configuration set.
 type validator struct {
 	displayNameTag *string
+	messageTag     *string
+	mapKeyLess     core.MapKeyLessFn
+	repanic        bool
+	configPolicy   ConfigErrorPolicy
+
+	registry   core.ValidatorRegistry
+	signatures core.SignatureRegistry
+	locales    *core.LocaleBundle
+	language   string
+	lock       sync.Mutex
+
+	aliases         parser.Aliases
+	aliasGeneration int
+	aliasLock       sync.RWMutex
+	expandedFields  map[*core.ReflectedField]*core.ReflectedField
 
-	registry core.ValidatorRegistry
-	locale   *core.Locale
-	lock     sync.Mutex
+	checkedStructs map[reflect.Type]core.ConfigErrors
 }
 
 func newValidator() *validator {
+	messageTag := "message"
+
 	validator := &validator{
-		registry: core.NewValidatorRegistry(),
-		locale:   core.NewLocale(),
+		messageTag:     &messageTag,
+		registry:       core.NewValidatorRegistry(),
+		signatures:     core.NewSignatureRegistry(),
+		locales:        core.NewLocaleBundle("en"),
+		language:       "en",
+		checkedStructs: map[reflect.Type]core.ConfigErrors{},
+		expandedFields: map[*core.ReflectedField]*core.ReflectedField{},
 	}
 
-	validators.RegisterDefaultLocale(validator.locale)
+	validators.RegisterDefaultLocales(validator.locales)
 	validators.RegisterDefaultValidators(validator.registry)
+	validators.RegisterDefaultSignatures(validator.signatures)
 
 	return validator
 }
@@ -50,14 +141,35 @@ func (this *validator) Copy() Validator {
 	newValidator := newValidator()
 
 	newValidator.displayNameTag = this.displayNameTag
-	newValidator.locale = this.locale.Copy()
+	newValidator.messageTag = this.messageTag
+	newValidator.mapKeyLess = this.mapKeyLess
+	newValidator.repanic = this.repanic
+	newValidator.configPolicy = this.configPolicy
+	newValidator.locales = this.locales.Copy()
+	newValidator.language = this.language
 	newValidator.registry = this.registry
+	newValidator.signatures = this.signatures
+
+	this.aliasLock.RLock()
+	newValidator.aliases = parser.Aliases{}
+	for name, alias := range this.aliases {
+		newValidator.aliases[name] = alias
+	}
+	this.aliasLock.RUnlock()
 
 	return newValidator
 }
 
 func (this *validator) Locale() *core.Locale {
-	return this.locale
+	return this.locales.EnsureLocale(this.language)
+}
+
+func (this *validator) Locales() *core.LocaleBundle {
+	return this.locales
+}
+
+func (this *validator) SetLanguage(tag string) {
+	this.language = core.NormalizeTag(tag)
 }
 
 func (this *validator) SetDisplayNameTag(tagName string) {
@@ -68,13 +180,49 @@ func (this *validator) SetDisplayNameTag(tagName string) {
 	}
 }
 
+func (this *validator) SetMessageTag(tagName string) {
+	if len(tagName) == 0 {
+		this.messageTag = nil
+	} else {
+		this.messageTag = &tagName
+	}
+}
+
+func (this *validator) SetMapKeyComparator(less core.MapKeyLessFn) {
+	this.mapKeyLess = less
+}
+
+func (this *validator) SetRepanic(repanic bool) {
+	this.repanic = repanic
+}
+
+func (this *validator) SetConfigErrorPolicy(policy ConfigErrorPolicy) {
+	this.configPolicy = policy
+}
+
 func (this *validator) Register(name string, validator core.ValidatorFn) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
 	this.registry.Register(name, validator)
+
+	// A newly registered validator might fix struct types that previously failed the check.
+	this.checkedStructs = map[reflect.Type]core.ConfigErrors{}
+}
+
+func (this *validator) RegisterSignature(name string, signature *core.Signature) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.signatures.Register(name, signature)
+	this.checkedStructs = map[reflect.Type]core.ConfigErrors{}
 }
 
-func (this *validator) Validate(value interface{}) core.ErrorList {
+func (this *validator) Validate(value interface{}, options ...ValidateOption) core.ErrorList {
 	context := &context{
 		validator: this,
+		options:   this.newValidateOptions(options),
+		root:      value,
 	}
 
 	walkValidate(context, value, nil)
@@ -82,12 +230,10 @@ func (this *validator) Validate(value interface{}) core.ErrorList {
 	return context.errors
 }
 
-// CheckSyntax checks the validate tag syntax of a structure.
+// CheckSyntax checks the validate tags of a structure and its nested structures using the default validator.
+// See Validator.CheckSyntax for details.
 func CheckSyntax(value interface{}) error {
-	if _, err := core.GetStructFields(value, "validator", nil); err != nil {
-		return err
-	}
-	return nil
+	return getGlobalValidator().CheckSyntax(value)
 }
 
 // New creates a new validator.
@@ -106,7 +252,12 @@ func Register(name string, validator core.ValidatorFn) {
 	getGlobalValidator().Register(name, validator)
 }
 
+// RegisterAlias registers an alias on the default validator. See Validator.RegisterAlias for details.
+func RegisterAlias(name string, expression string) error {
+	return getGlobalValidator().RegisterAlias(name, expression)
+}
+
 // Validate validates fields of a structure, or structures of a map, slice or array using the default validator.
-func Validate(value interface{}) core.ErrorList {
-	return getGlobalValidator().Validate(value)
+func Validate(value interface{}, options ...ValidateOption) core.ErrorList {
+	return getGlobalValidator().Validate(value, options...)
 }
@@ -1,9 +1,12 @@
 package validator_test
 
 import (
+	"errors"
 	. "github.com/typerandom/validator"
 	"github.com/typerandom/validator/core"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestThatValidatorDefaultIsNotNil(t *testing.T) {
@@ -146,3 +149,682 @@ func TestThatValidatorDoesntValidateNilStructValue(t *testing.T) {
 		t.Fatalf("Expected error to be 'NonNilStruct.Value cannot be empty.' but it was '%s'.", firstError.String())
 	}
 }
+
+func TestThatValidatorReportsFieldPathsOfSharedNestedStructs(t *testing.T) {
+	type Address struct {
+		Street string `validate:"not_empty"`
+	}
+
+	type Dummy struct {
+		Home Address
+		Work Address
+	}
+
+	errs := Validate(&Dummy{})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, but got %d.", errs.Length())
+	}
+
+	if errs[0].GetFieldName() != "Home.Street" || errs[1].GetFieldName() != "Work.Street" {
+		t.Fatalf("Expected errors for 'Home.Street' and 'Work.Street', but got '%s' and '%s'.", errs[0].GetFieldName(), errs[1].GetFieldName())
+	}
+}
+
+func newPanickingValidator() Validator {
+	validator := New()
+
+	validator.Register("panics", func(ctx core.ValidatorContext, args []interface{}) error {
+		var value *string
+		return errors.New(*value)
+	})
+
+	return validator
+}
+
+type panicDummy struct {
+	Name  string `validate:"panics"`
+	Other string `validate:"not_empty"`
+}
+
+func TestThatValidatorRecoversPanicsOfValidators(t *testing.T) {
+	errs := newPanickingValidator().Validate(&panicDummy{})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, but got %d.", errs.Length())
+	}
+
+	firstErr := errs.First()
+
+	if firstErr.GetFieldName() != "Name" || firstErr.GetValidatorName() != "panics" {
+		t.Fatalf("Expected panic error of validator 'panics' on field 'Name', but got '%s' on '%s'.", firstErr.GetValidatorName(), firstErr.GetFieldName())
+	}
+
+	var panicErr *core.PanicError
+
+	if !errors.As(firstErr, &panicErr) {
+		t.Fatalf("Expected panic error, but got '%s'.", firstErr)
+	}
+
+	if len(panicErr.Stack) == 0 {
+		t.Fatal("Expected panic error to have a stack trace, but it didn't.")
+	}
+
+	if !strings.HasPrefix(firstErr.Error(), "Validator 'panics' on field 'Name' panicked: ") {
+		t.Fatalf("Expected panic error message, but got '%s'.", firstErr)
+	}
+
+	if key := firstErr.GetLocaleKey(); key != "validator.panicked" {
+		t.Fatalf("Expected locale key 'validator.panicked', but got '%s'.", key)
+	}
+
+	if err := newPanickingValidator().Validate(&panicDummy{}, WithLanguage("de")).First(); err == nil || !strings.HasPrefix(err.Error(), "Der Validator 'panics' für das Feld 'Name' ist abgestürzt: ") {
+		t.Fatalf("Expected German panic error message, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorRepanicsWhenEnabled(t *testing.T) {
+	validator := newPanickingValidator()
+	validator.SetRepanic(true)
+
+	defer func() {
+		recovered := recover()
+
+		err, ok := recovered.(*core.Error)
+
+		if !ok {
+			t.Fatalf("Expected panic with *core.Error, but got '%v'.", recovered)
+		}
+
+		if err.GetFieldName() != "Name" {
+			t.Fatalf("Expected panic for field 'Name', but got '%s'.", err.GetFieldName())
+		}
+	}()
+
+	validator.Validate(&panicDummy{})
+
+	t.Fatal("Expected panic, but didn't get any.")
+}
+
+func TestThatValidatorProducesMessagesInSelectedLanguage(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"not_empty"`
+	}
+
+	validator := New()
+	validator.Locales().EnsureLocale("pt-BR").Set("notEmpty.cannotBeEmpty", "{field} é obrigatório.")
+
+	for language, expected := range map[string]string{
+		"en":    "Value cannot be empty.",
+		"de":    "Value darf nicht leer sein.",
+		"pt-PT": "Value não pode estar vazio.",
+		"pt-BR": "Value é obrigatório.",
+		"ja":    "Value cannot be empty.",
+	} {
+		validator.SetLanguage(language)
+
+		if err := validator.Validate(&Dummy{}).First(); err == nil || err.Error() != expected {
+			t.Fatalf("Expected '%s' for language '%s', but got '%v'.", expected, language, err)
+		}
+	}
+}
+
+func TestThatValidatorProducesMessagesInLanguageOfSingleCall(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"not_empty"`
+	}
+
+	validator := New()
+	validator.SetLanguage("fr")
+
+	if err := validator.Validate(&Dummy{}, WithLanguage("de")).First(); err == nil || err.Error() != "Value darf nicht leer sein." {
+		t.Fatalf("Expected German message, but got '%v'.", err)
+	}
+
+	language := validator.Locales().Negotiate("da, es-MX;q=0.8, en;q=0.5")
+
+	if err := validator.Validate(&Dummy{}, WithLanguage(language)).First(); err == nil || err.Error() != "Value no puede estar vacío." {
+		t.Fatalf("Expected Spanish message, but got '%v'.", err)
+	}
+
+	if err := validator.Validate(&Dummy{}).First(); err == nil || err.Error() != "Value ne peut pas être vide." {
+		t.Fatalf("Expected French message, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorRendersNamedPlaceholdersAndTemplates(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"min(5)"`
+	}
+
+	validator := New()
+
+	if err := validator.Validate(&Dummy{Name: "Bob"}).First(); err == nil || err.Error() != "Name cannot be shorter than 5 characters." {
+		t.Fatalf("Expected min error, but got '%v'.", err)
+	}
+
+	validator.Locale().Set("min.cannotBeShorterThan", "{{.Field}} '{{.Value}}' needs {{.Params.min}}+ characters.")
+
+	if err := validator.Validate(&Dummy{Name: "Bob"}).First(); err == nil || err.Error() != "Name 'Bob' needs 5+ characters." {
+		t.Fatalf("Expected templated min error, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorSelectsPluralFormsOfMessages(t *testing.T) {
+	type Dummy struct {
+		Name  string   `validate:"min(1)"`
+		Items []string `validate:"min(3)"`
+	}
+
+	validator := New()
+
+	validator.Locales().EnsureLocale("ru").SetPlural("min.cannotContainLessItemsThan", "min", map[core.PluralCategory]string{
+		core.PluralOne:  "{field}: минимум # элемент.",
+		core.PluralFew:  "{field}: минимум # элемента.",
+		core.PluralMany: "{field}: минимум # элементов.",
+	})
+
+	errs := validator.Validate(&Dummy{})
+
+	if errs.Length() != 2 || errs[0].Error() != "Name cannot be shorter than 1 character." || errs[1].Error() != "Items cannot contain less than 3 items." {
+		t.Fatalf("Expected singular and plural English messages, but got %v.", errs)
+	}
+
+	if err := validator.Validate(&Dummy{Name: "a"}, WithLanguage("ru")).First(); err == nil || err.Error() != "Items: минимум 3 элемента." {
+		t.Fatalf("Expected Russian plural message, but got '%v'.", err)
+	}
+
+	if err := validator.Validate(&Dummy{}, WithLanguage("ru")).First(); err == nil || err.Error() != "Name cannot be shorter than 1 character." {
+		t.Fatalf("Expected English fallback message, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorUsesCustomMessagesOfFields(t *testing.T) {
+	type Dummy struct {
+		Username string `validate:"min(3),max(16)" message:"Please pick a username between 3 and 16 letters."`
+		Email    string `validate:"not_empty,regexp(´@´)" message.regexp:"{field} '{value}' isn't an email address."`
+		Code     string `validate:"empty|min(4),uppercase" message.2:"custom.code" message.uppercase:"{field} must be upper case."`
+	}
+
+	validator := New()
+	validator.Locale().Set("custom.code", "{field} must have at least {min} characters.")
+	validator.Locales().EnsureLocale("de").Set("custom.code", "{field} braucht mindestens {min} Zeichen.")
+
+	errs := validator.Validate(&Dummy{Username: "ab", Email: "bob", Code: "abc"})
+
+	expected := []string{
+		"Please pick a username between 3 and 16 letters.",
+		"Email 'bob' isn't an email address.",
+		"Code must have at least 4 characters.",
+		"Code must be upper case.",
+	}
+
+	if errs.Length() != len(expected) {
+		t.Fatalf("Expected %d errors, but got %v.", len(expected), errs)
+	}
+
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Fatalf("Expected error '%s', but got '%s'.", expected[i], err.Error())
+		}
+	}
+
+	if key := errs[1].GetLocaleKey(); key != "regexp.mustMatchPattern" {
+		t.Fatalf("Expected custom message to keep locale key 'regexp.mustMatchPattern', but got '%s'.", key)
+	}
+
+	if err := validator.Validate(&Dummy{Username: "abc", Email: "a@b", Code: "ABC"}, WithLanguage("de")).First(); err == nil || err.Error() != "Code braucht mindestens 4 Zeichen." {
+		t.Fatalf("Expected German custom message, but got '%v'.", err)
+	}
+
+	validator.SetMessageTag("")
+
+	if err := validator.Validate(&Dummy{Username: "ab", Email: "a@b"}).First(); err == nil || err.Error() != "Username cannot be shorter than 3 characters." {
+		t.Fatalf("Expected custom messages to be disabled, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorKeepsPercentSignsInCustomMessages(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"!contain('x')" message:"Name must be 100% free of x"`
+		Code string `validate:"max(3)" message:"{field} must be under {max}% of the limit"`
+	}
+
+	errs := Validate(&Dummy{Name: "xyz", Code: "abcd"})
+
+	expected := []string{"Name must be 100% free of x", "Code must be under 3% of the limit"}
+
+	if errs.Length() != len(expected) {
+		t.Fatalf("Expected %d errors, but got %v.", len(expected), errs)
+	}
+
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Fatalf("Expected error '%s', but got '%s'.", expected[i], err.Error())
+		}
+	}
+}
+
+func TestThatValidatorLocalizesDisplayNamesOfFields(t *testing.T) {
+	type Address struct {
+		Street string `validate:"min(1)" label:"labels.street"`
+		Zip    string `validate:"min(1)" label:"Zip code"`
+	}
+
+	type User struct {
+		Email string `validate:"not_empty"`
+		Home  Address
+	}
+
+	validator := New()
+	validator.SetDisplayNameTag("label")
+
+	german := validator.Locales().EnsureLocale("de")
+	german.Set("fields.User.Email", "E-Mail")
+	german.Set("fields.User.Home", "Wohnsitz")
+	german.Set("labels.street", "Straße")
+
+	errs := validator.Validate(&User{}, WithLanguage("de"))
+
+	expected := []string{"E-Mail darf nicht leer sein.", "Wohnsitz.Straße", "Wohnsitz.Zip code"}
+
+	if errs.Length() != 3 || errs[0].Error() != expected[0] || errs[1].GetFieldDisplayName() != expected[1] || errs[2].GetFieldDisplayName() != expected[2] {
+		t.Fatalf("Expected localized display names %v, but got %v.", expected, errs)
+	}
+
+	if name := validator.Validate(&User{}).First().GetFieldDisplayName(); name != "Email" {
+		t.Fatalf("Expected untranslated display name 'Email', but got '%s'.", name)
+	}
+}
+
+func TestThatValidatorCopyHasIndependentLocales(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"not_empty"`
+	}
+
+	original := New()
+	copied := original.Copy()
+	copied.Locale().Set("notEmpty.cannotBeEmpty", "{field} is required.")
+
+	if err := original.Validate(&Dummy{}).First(); err == nil || err.Error() != "Name cannot be empty." {
+		t.Fatalf("Expected original message, but got '%v'.", err)
+	}
+
+	if err := copied.Validate(&Dummy{}).First(); err == nil || err.Error() != "Name is required." {
+		t.Fatalf("Expected message of copy, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorInvertsNegatedMethods(t *testing.T) {
+	type Dummy struct {
+		Role     string `validate:"!contain(´admin´)"`
+		Name     string `validate:"!empty"`
+		Code     string `validate:"!numeric"`
+		Optional string `validate:"empty|!lowercase"`
+		Unknown  string `validate:"!unknown_validator"`
+	}
+
+	validator := New()
+
+	errs := validator.Validate(&Dummy{Role: "superadmin", Code: "123", Optional: "abc"})
+
+	expected := []string{
+		"Role cannot contain 'admin'.",
+		"Name cannot be empty.",
+		"Code must not satisfy numeric.",
+		"Optional must not satisfy lowercase.",
+		"Validator 'unknown_validator' is not registered.",
+	}
+
+	if errs.Length() != len(expected) {
+		t.Fatalf("Expected %d errors, but got %v.", len(expected), errs)
+	}
+
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Fatalf("Expected error '%s', but got '%s'.", expected[i], err.Error())
+		}
+	}
+
+	if key := errs[2].GetLocaleKey(); key != "negation.mustNotSatisfy" {
+		t.Fatalf("Expected locale key 'negation.mustNotSatisfy', but got '%s'.", key)
+	}
+
+	if err := validator.Validate(&Dummy{Role: "user", Name: "Bob", Code: "abc", Optional: "ABC"}, WithLanguage("de")).First(); err == nil || !err.IsConfigError() {
+		t.Fatalf("Expected only the config error, but got '%v'.", err)
+	}
+
+	if err := validator.Validate(&Dummy{Role: "admin", Name: "Bob", Code: "abc"}, WithLanguage("de")).First(); err == nil || err.Error() != "Role darf 'admin' nicht enthalten." {
+		t.Fatalf("Expected German negated message, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorCancelsDoubleNegations(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"!(!empty)"`
+	}
+
+	if errs := Validate(&Dummy{}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if err := Validate(&Dummy{Name: "Bob"}).First(); err == nil || err.GetValidatorName() != "empty" || err.Error() != "Name is not empty." {
+		t.Fatalf("Expected empty error, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorEvaluatesNestedExpressions(t *testing.T) {
+	type Dummy struct {
+		Code  string `validate:"(empty | (lowercase, min(5))), max(10)"`
+		Label string `validate:"!(empty | lowercase)"`
+	}
+
+	for _, valid := range []*Dummy{{Code: "", Label: "Abc"}, {Code: "abcde", Label: "ABC"}, {Code: "abcdefghij", Label: "aBC"}} {
+		if errs := Validate(valid); errs.Any() {
+			t.Fatalf("Didn't expect error for %v, got %s.", valid, errs.First())
+		}
+	}
+
+	errs := Validate(&Dummy{Code: "ABC", Label: "abc"})
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, but got %v.", errs)
+	}
+
+	if errs[0].GetValidatorName() != "lowercase" || errs[1].GetValidatorName() != "min" {
+		t.Fatalf("Expected errors of the failed branch 'lowercase, min(5)', but got '%s' and '%s'.", errs[0], errs[1])
+	}
+
+	if branch := errs[1].GetBranch(); len(branch) != 1 || branch[0] != 2 {
+		t.Fatalf("Expected errors of branch [2], but got %v.", branch)
+	}
+
+	if expected := "Label must not satisfy empty | lowercase."; errs[2].Error() != expected {
+		t.Fatalf("Expected error '%s', but got '%s'.", expected, errs[2])
+	}
+
+	if errs = Validate(&Dummy{Code: "abcdefghijk", Label: "A"}); errs.Length() != 1 || errs[0].GetValidatorName() != "max" || errs[0].GetBranch() != nil {
+		t.Fatalf("Expected only the max error outside of any branch, but got %v.", errs)
+	}
+}
+
+func TestThatValidatorAcceptsListArguments(t *testing.T) {
+	type Dummy struct {
+		Role   string `validate:"equal([admin, ´power user´, guest])"`
+		Level  int    `validate:"not([0, 13])"`
+		Domain string `validate:"contain([´.com´, ´.org´])"`
+	}
+
+	if errs := Validate(&Dummy{Role: "power user", Level: 1, Domain: "example.org"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := Validate(&Dummy{Role: "root", Level: 13, Domain: "example.net"})
+
+	expected := []string{
+		"Role must equal one of the following values 'admin, power user, guest'.",
+		"Level cannot be 13.",
+		"Domain must contain one of the following values '.com, .org'.",
+	}
+
+	if errs.Length() != len(expected) {
+		t.Fatalf("Expected %d errors, but got %v.", len(expected), errs)
+	}
+
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Fatalf("Expected error '%s', but got '%s'.", expected[i], err.Error())
+		}
+	}
+
+	if err := New().CheckSyntax(&Dummy{}); err != nil {
+		t.Fatalf("Didn't expect syntax errors, got %v.", err)
+	}
+}
+
+func TestThatValidatorResolvesReferences(t *testing.T) {
+	type Limits struct {
+		MaxTags int
+	}
+
+	type Account struct {
+		Password string
+		Confirm  string   `validate:"equal($Password)"`
+		Tags     []string `validate:"max($root.Limits.MaxTags)"`
+		Seats    int      `validate:"max($ctx.tenantMax)"`
+	}
+
+	type Signup struct {
+		Limits  Limits
+		Account *Account
+	}
+
+	validator := New()
+
+	signup := &Signup{Limits: Limits{MaxTags: 2}, Account: &Account{Password: "secret", Confirm: "secret", Tags: []string{"a", "b"}, Seats: 5}}
+
+	if errs := validator.Validate(signup, WithValue("tenantMax", 5)); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	signup.Account.Confirm = "secrets"
+	signup.Account.Tags = append(signup.Account.Tags, "c")
+	signup.Account.Seats = 6
+
+	errs := validator.Validate(signup, WithValue("tenantMax", uint8(5)))
+
+	expected := []string{
+		"Account.Confirm must equal one of the following values 'secret'.",
+		"Account.Tags cannot contain more than 2 items.",
+		"Account.Seats cannot be greater than 5.",
+	}
+
+	if errs.Length() != len(expected) {
+		t.Fatalf("Expected %d errors, but got %v.", len(expected), errs)
+	}
+
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Fatalf("Expected error '%s', but got '%s'.", expected[i], err.Error())
+		}
+	}
+
+	signup.Account.Seats = 1
+
+	if err := validator.Validate(signup).WithField("Account.Seats").First(); err == nil || !err.IsConfigError() || err.Error() != "Reference '$ctx.tenantMax' can't be resolved." {
+		t.Fatalf("Expected unresolved reference config error, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorResolvesReferencesThroughNilEmbeddedPointers(t *testing.T) {
+	type Base struct {
+		Limit int64
+	}
+
+	type Dummy struct {
+		*Base
+		Name string `validate:"max($Limit)"`
+	}
+
+	if errs := New().Validate(&Dummy{Base: &Base{Limit: 3}, Name: "abc"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	// The nil embedded pointer resolves the reference to nil, which max doesn't accept.
+	if err := New().Validate(&Dummy{Name: "abc"}).First(); err == nil || !err.IsConfigError() {
+		t.Fatalf("Expected config error, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorExpandsAliases(t *testing.T) {
+	type Dummy struct {
+		Username string `validate:"username"`
+		Slug     string `validate:"empty|slug(3, 8)"`
+		Handle   string `validate:"!username"`
+	}
+
+	validator := New()
+
+	if err := validator.RegisterAlias("username", "min(3),max(32),lowercase"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := validator.RegisterAlias("slug(lo, hi)", "min($lo),max($hi),regexp(´^[a-z0-9-]+$´)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if errs := validator.Validate(&Dummy{Username: "alice", Slug: "my-slug", Handle: "Alice"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := validator.Validate(&Dummy{Username: "Al", Slug: "my_long_slug", Handle: "alice"})
+
+	expected := []string{
+		"Username cannot be shorter than 3 characters.",
+		"Username must be in lower case.",
+		"Slug cannot be longer than 8 characters.",
+		"Slug must match pattern '^[a-z0-9-]+$'.",
+		"Handle must not satisfy min(3), max(32), lowercase.",
+	}
+
+	if errs.Length() != len(expected) {
+		t.Fatalf("Expected %d errors, but got %v.", len(expected), errs)
+	}
+
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Fatalf("Expected error '%s', but got '%s'.", expected[i], err.Error())
+		}
+	}
+
+	if err := validator.CheckSyntax(&Dummy{}); err != nil {
+		t.Fatalf("Didn't expect syntax errors, got %v.", err)
+	}
+
+	if errs := New().Validate(&Dummy{Username: "alice"}); errs.First() == nil || !errs.First().IsConfigError() {
+		t.Fatalf("Expected aliases of other validators to be unknown, but got %v.", errs)
+	}
+}
+
+func TestThatRegisteringAliasFailsForCycles(t *testing.T) {
+	validator := New()
+
+	if err := validator.RegisterAlias("a", "min(1),b"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := validator.RegisterAlias("b", "empty|a"); err == nil || err.Error() != "Alias 'b' refers to itself: b -> a -> b." {
+		t.Fatalf("Expected cycle error, but got '%v'.", err)
+	}
+
+	if err := validator.RegisterAlias("c(x)", "max($x)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	type Dummy struct {
+		Value string `validate:"c(1, 2)"`
+	}
+
+	if err := validator.CheckSyntax(&Dummy{}); err == nil || err.Error() != "validator_test.Dummy.Value: Alias 'c' expects the arguments (x), but got 2." {
+		t.Fatalf("Expected argument count error, but got '%v'.", err)
+	}
+
+	if err := validator.Validate(&Dummy{}, WithLanguage("de")).First(); err == nil || err.Error() != "validator_test.Dummy.Value: Der Alias 'c' erwartet die Argumente (x), hat aber 2 erhalten." {
+		t.Fatalf("Expected German argument count error, but got '%v'.", err)
+	}
+}
+
+func TestThatNumericArgumentsAreComparedWithoutLosingPrecision(t *testing.T) {
+	type Dummy struct {
+		Id    int64   `validate:"min(0x1F_FFFF_FFFF_FFFF)"`
+		Ratio float64 `validate:"max(1.5e-3)"`
+		Flags uint8   `validate:"max(0b1111)"`
+	}
+
+	errs := Validate(&Dummy{Id: 0x1F_FFFF_FFFF_FFFE, Ratio: 0.002, Flags: 0xF})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, but got %d (%v).", len(errs), errs)
+	}
+
+	if errs[0].Error() != "Id cannot be less than 9007199254740991." {
+		t.Fatalf("Unexpected error '%s'.", errs[0].Error())
+	}
+
+	if errs[1].Error() != "Ratio cannot be greater than 0.0015." {
+		t.Fatalf("Unexpected error '%s'.", errs[1].Error())
+	}
+}
+
+func TestThatValidatorsReceiveKeywordArguments(t *testing.T) {
+	type Dummy struct {
+		Max     int64
+		Name    string   `validate:"between(max=$Max, min=2)"`
+		Created string   `validate:"time(layout='2006-01-02')"`
+		Tags    []string `validate:"len(max=2)"`
+	}
+
+	validator := New()
+	validator.Locale().Set("test.notBetween", "{field} must be between {min} and {max} characters.")
+
+	validator.Register("between", func(ctx core.ValidatorContext, args []interface{}) error {
+		min, _ := ctx.Arguments().Keyword("min")
+		max, _ := ctx.Arguments().Keyword("max")
+		length := int64(len(ctx.Value().(string)))
+
+		if length < min.(int64) || length > max.(int64) {
+			return ctx.NewError("test.notBetween", core.Params{"min": min, "max": max})
+		}
+
+		return nil
+	})
+
+	// Validators only receive the keyword arguments that their signature declares.
+	validator.RegisterSignature("between", &core.Signature{Keywords: map[string]core.ArgKind{"min": core.NumberArg, "max": core.NumberArg}})
+
+	if errs := validator.Validate(&Dummy{Max: 5, Name: "alice", Created: "2013-06-05"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := validator.Validate(&Dummy{Max: 4, Name: "alice", Created: "05.06.2013", Tags: []string{"a", "b", "c"}})
+
+	if errs.Length() != 3 || errs[0].Error() != "Name must be between 2 and 4 characters." || errs[2].Error() != "Tags cannot contain more than 2 items." {
+		t.Fatalf("Expected 2 errors, but got %v.", errs)
+	}
+
+	if key := errs[2].GetLocaleKey(); key != "len.cannotContainMoreItemsThan" {
+		t.Fatalf("Expected locale key 'len.cannotContainMoreItemsThan', but got '%s'.", key)
+	}
+
+	type Code struct {
+		Value string `validate:"len(min=2)" message.len:"{field} needs at least {min} characters."`
+	}
+
+	if err := validator.Validate(&Code{Value: "a"}).First(); err == nil || err.Error() != "Value needs at least 2 characters." {
+		t.Fatalf("Expected custom len message, but got '%v'.", err)
+	}
+
+	type Event struct {
+		Start string `validate:"time(layout=´2006-01-02´, after=´now´)"`
+	}
+
+	if errs := validator.Validate(&Event{Start: time.Now().Add(48 * time.Hour).Format("2006-01-02")}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if err := validator.Validate(&Event{Start: "2013-06-05"}).First(); err == nil || err.Error() != "Start must be after now." {
+		t.Fatalf("Expected time bound error, but got '%v'.", err)
+	}
+
+	if err := validator.CheckSyntax(&Event{}); err != nil {
+		t.Fatalf("Didn't expect syntax errors, got %v.", err)
+	}
+
+	type Invalid struct {
+		Created string `validate:"time(format='2006-01-02')"`
+	}
+
+	if err := validator.CheckSyntax(&Invalid{}); err == nil || err.Error() != "validator_test.Invalid.Created: Validator 'time' has no keyword argument 'format'." {
+		t.Fatalf("Expected unknown keyword error, but got '%v'.", err)
+	}
+}
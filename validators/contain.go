@@ -2,31 +2,57 @@ package validators
 
 import (
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	"strings"
 )
 
 func ContainValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) != 1 {
-		return context.NewError("arguments.singleRequired")
+		return context.NewConfigError("arguments.singleRequired")
 	}
 
-	if testValue, ok := args[0].(string); ok {
-		switch typedValue := context.Value().(type) {
-		case string:
-			if len(testValue) == 0 {
-				return context.NewError("arguments.invalid")
+	var testValues []string
+
+	switch typedArg := args[0].(type) {
+	case string:
+		testValues = []string{typedArg}
+	case parser.List:
+		for _, value := range typedArg {
+			testValue, ok := value.(string)
+
+			if !ok {
+				return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "string or list"})
 			}
 
-			if context.IsNil() || !strings.Contains(typedValue, testValue) {
-				return context.NewError("contain.mustContainValue", testValue)
+			testValues = append(testValues, testValue)
+		}
+	default:
+		return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "string or list"})
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if len(testValues) == 0 {
+			return context.NewConfigError("arguments.invalid")
+		}
+
+		for _, testValue := range testValues {
+			if len(testValue) == 0 {
+				return context.NewConfigError("arguments.invalid")
 			}
+		}
 
-			return nil
+		if !context.IsNil() {
+			for _, testValue := range testValues {
+				if strings.Contains(typedValue, testValue) {
+					return nil
+				}
+			}
 		}
-		/*TODO: Add support for checking if a value is present in a slice/array/map.*/
-	} else {
-		return context.NewError("arguments.invalidType", 1, "string")
+
+		return context.NewError("contain.mustContainValue", core.Params{"values": strings.Join(testValues, ", ")})
 	}
+	/*TODO: Add support for checking if a value is present in a slice/array/map.*/
 
 	return context.NewError("type.unsupported")
 }
@@ -3,6 +3,7 @@ package validators_test
 import (
 	"fmt"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	. "github.com/typerandom/validator/validators"
 	"testing"
 )
@@ -79,3 +80,19 @@ func TestThatContainValidatorFailsForUnsupportedValueType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatContainValidatorSucceedsForAnyValueOfList(t *testing.T) {
+	values := parser.List{"foo", "bar"}
+
+	if err := ContainValidator(core.NewTestContext("a bar b"), []interface{}{values}); err != nil {
+		t.Fatalf("Didn't expect error, but got %s.", err)
+	}
+
+	if err := ContainValidator(core.NewTestContext("baz"), []interface{}{values}); err == nil || err.Error() != "contain.mustContainValue" {
+		t.Fatalf("Expected must contain value error, but got '%v'.", err)
+	}
+
+	if err := ContainValidator(core.NewTestContext("baz"), []interface{}{parser.List{"foo", 1.0}}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, but got '%v'.", err)
+	}
+}
@@ -8,7 +8,7 @@ import (
 
 func EmptyValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) > 0 {
-		return context.NewError("arguments.noneSupported")
+		return context.NewConfigError("arguments.noneSupported")
 	}
 
 	if context.IsNil() {
@@ -2,48 +2,74 @@ package validators
 
 import (
 	"github.com/typerandom/validator/core"
+	"math/big"
 	"strconv"
 )
 
-func EqualValidator(context core.ValidatorContext, args []interface{}) error {
-	if len(args) != 1 {
-		return context.NewError("arguments.singleRequired")
-	}
-
-	if testValue, ok := args[0].(string); ok {
-		switch typedValue := context.Value().(type) {
+// equalsArgument indicates whether or not a value equals an argument. String arguments are parsed as the type of
+// the value, booleans are compared to values of the same type and numbers to numbers of any type, both also to their
+// string representation.
+func equalsArgument(value interface{}, argument interface{}) bool {
+	switch typedArgument := argument.(type) {
+	case string:
+		switch typedValue := value.(type) {
 		case string:
-			if !context.IsNil() && typedValue == testValue {
-				return nil
-			}
-			return context.NewError("equal.mustEqualValue", testValue)
+			return typedValue == typedArgument
 		case int64:
-			parsedTestValue, err := strconv.ParseInt(testValue, 10, 64)
+			parsedArgument, err := strconv.ParseInt(typedArgument, 10, 64)
+			return err == nil && typedValue == parsedArgument
+		case float64:
+			parsedArgument, err := strconv.ParseFloat(typedArgument, 64)
+			return err == nil && typedValue == parsedArgument
+		case bool:
+			parsedArgument, err := strconv.ParseBool(typedArgument)
+			return err == nil && typedValue == parsedArgument
+		}
+	case int64, uint64, *big.Int, float64:
+		switch typedValue := value.(type) {
+		case string:
+			return typedValue == formatNumber(typedArgument)
+		case int64, float64:
+			comparison, ok := core.CompareNumbers(typedValue, typedArgument)
+			return ok && comparison == 0
+		}
+	case bool:
+		switch typedValue := value.(type) {
+		case string:
+			return typedValue == strconv.FormatBool(typedArgument)
+		case bool:
+			return typedValue == typedArgument
+		}
+	}
 
-			if err == nil && !context.IsNil() && typedValue == parsedTestValue {
-				return nil
-			}
+	return false
+}
 
-			return context.NewError("equal.mustEqualValue", testValue)
-		case float64:
-			parsedTestValue, err := strconv.ParseFloat(testValue, 64)
+func EqualValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) != 1 {
+		return context.NewConfigError("arguments.singleRequired")
+	}
 
-			if err == nil && !context.IsNil() && typedValue == parsedTestValue {
-				return nil
-			}
+	values := argumentValues(args[0])
 
-			return context.NewError("equal.mustEqualValue", testValue)
-		case bool:
-			parsedTestValue, err := strconv.ParseBool(testValue)
+	switch context.Value().(type) {
+	case string, int64, float64, bool:
+		if context.IsNil() {
+			for _, value := range values {
+				if value == nil {
+					return nil
+				}
+			}
+			return context.NewError("equal.mustEqualValue", core.Params{"values": formatValues(values)})
+		}
 
-			if err == nil && !context.IsNil() && typedValue == parsedTestValue {
+		for _, value := range values {
+			if equalsArgument(context.Value(), value) {
 				return nil
 			}
-
-			return context.NewError("equal.mustEqualValue", testValue)
 		}
-	} else {
-		return context.NewError("arguments.invalidType", 1, "string")
+
+		return context.NewError("equal.mustEqualValue", core.Params{"values": formatValues(values)})
 	}
 
 	return context.NewError("type.unsupported")
@@ -3,6 +3,7 @@ package validators_test
 import (
 	"fmt"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	. "github.com/typerandom/validator/validators"
 	"testing"
 )
@@ -110,3 +111,19 @@ func TestThatEqualValidatorFailsForUnsupportedValueType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatEqualValidatorSucceedsForAnyValueOfList(t *testing.T) {
+	values := parser.List{"abc", float64(5), true}
+
+	for _, dummy := range []interface{}{"abc", 5, 5.0, "5", true} {
+		if err := EqualValidator(core.NewTestContext(dummy), []interface{}{values}); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", dummy, err)
+		}
+	}
+
+	for _, dummy := range []interface{}{"abcd", 6, 5.5, false} {
+		if err := EqualValidator(core.NewTestContext(dummy), []interface{}{values}); err == nil || err.Error() != "equal.mustEqualValue" {
+			t.Fatalf("Expected must equal value error for %v, but got '%v'.", dummy, err)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package validators
 
 import (
-	"errors"
 	"github.com/typerandom/validator/core"
 )
 
@@ -18,17 +17,22 @@ func FuncValidator(context core.ValidatorContext, args []interface{}) error {
 				funcArgs = args[1:]
 			}
 		} else {
-			return context.NewError("arguments.invalidType", 1, "string")
+			return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "string"})
 		}
 	}
 
 	returnValues, err := core.CallDynamicMethod(context.Source(), funcName, context, funcArgs)
 
-	if err != nil {
-		if err == core.InvalidMethodError {
-			return errors.New("Validation method '" + context.Field().Parent.FullName(funcName) + "' on field '{field}' does not exist.")
-		}
-		return err
+	params := core.Params{"method": context.Field().Parent.FullName(funcName)}
+
+	switch err {
+	case nil:
+	case core.InvalidMethodError:
+		return core.NewConfigError(core.NewMessage("func.methodDoesNotExist", "Validation method '{method}' on field '{field}' does not exist.", context.Value(), params))
+	case core.InputParameterMismatchError:
+		return core.NewConfigError(core.NewMessage("func.invalidParameters", "Validation method '{method}' must accept a core.ValidatorContext and a slice of arguments.", context.Value(), params))
+	default:
+		return core.NewConfigError(core.NewMessage("func.callFailed", "Unable to call validation method '{method}'.", context.Value(), params))
 	}
 
 	if len(returnValues) == 1 {
@@ -39,5 +43,5 @@ func FuncValidator(context core.ValidatorContext, args []interface{}) error {
 		}
 	}
 
-	return errors.New("Invalid return value(s) of validation method '" + context.Field().Parent.FullName(funcName) + "'. Return value must be of type 'error'.")
+	return core.NewConfigError(core.NewMessage("func.invalidReturnValue", "Invalid return value(s) of validation method '{method}'. Return value must be of type 'error'.", context.Value(), params))
 }
@@ -0,0 +1,82 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"reflect"
+)
+
+// LenValidator checks the length of strings, arrays, slices and maps against a minimum and a maximum, which are given
+// by position or keyword, i.e. "len(3, 16)", "len(min=3, max=16)" or "len(max=16)". At least one of them is required.
+func LenValidator(context core.ValidatorContext, args []interface{}) error {
+	arguments := validatorArguments(context, args)
+
+	if arguments.Len() == 0 {
+		return context.NewConfigError("arguments.oneOrMoreRequired")
+	}
+
+	if len(args) > 2 || arguments.Len() > 2 {
+		return context.NewConfigError("arguments.invalid")
+	}
+
+	bounds := make([]interface{}, 2)
+
+	for i, name := range []string{"min", "max"} {
+		bound, ok := arguments.Get(i, name)
+
+		if !ok {
+			continue
+		}
+
+		_, isKeyword := arguments.Keyword(name)
+
+		if isKeyword && i < len(args) {
+			// The bound is given both by position and by keyword.
+			return context.NewConfigError("arguments.invalid")
+		}
+
+		if !core.IsNumber(bound) {
+			var position interface{} = i + 1
+
+			if isKeyword {
+				position = name
+			}
+
+			return context.NewConfigError("arguments.invalidType", core.Params{"position": position, "type": "number"})
+		}
+
+		bounds[i] = bound
+	}
+
+	var length int64
+	var keys [2]string
+
+	if typedValue, ok := context.Value().(string); ok {
+		length = int64(len(typedValue))
+		keys = [2]string{"len.cannotBeShorterThan", "len.cannotBeLongerThan"}
+
+		if context.IsNil() && bounds[0] != nil {
+			return context.NewError(keys[0], core.Params{"min": bounds[0]})
+		}
+	} else {
+		switch context.OriginalKind() {
+		case reflect.Array, reflect.Slice:
+			keys = [2]string{"len.cannotContainLessItemsThan", "len.cannotContainMoreItemsThan"}
+		case reflect.Map:
+			keys = [2]string{"len.cannotContainLessKeysThan", "len.cannotContainMoreKeysThan"}
+		default:
+			return context.NewError("type.unsupported")
+		}
+
+		length = int64(reflect.ValueOf(context.Value()).Len())
+	}
+
+	if minValue := bounds[0]; minValue != nil && compareNumber(length, minValue) < 0 {
+		return context.NewError(keys[0], core.Params{"min": minValue})
+	}
+
+	if maxValue := bounds[1]; maxValue != nil && compareNumber(length, maxValue) > 0 {
+		return context.NewError(keys[1], core.Params{"max": maxValue})
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func testThatLenValidatorReturnsError(t *testing.T, dummy interface{}, args []interface{}, keywords parser.Keywords, expectedErr string) {
+	ctx := core.NewTestContext(dummy)
+	ctx.SetArguments(core.NewArguments(args, keywords))
+
+	err := LenValidator(ctx, args)
+
+	if expectedErr == "" {
+		if err != nil {
+			t.Fatalf("Tested %v with %v and %v. Didn't expect error, but got %s.", dummy, args, keywords, err)
+		}
+		return
+	}
+
+	if err == nil || err.Error() != expectedErr {
+		t.Fatalf("Tested %v with %v and %v. Expected error '%s', but got '%v'.", dummy, args, keywords, expectedErr, err)
+	}
+}
+
+func TestThatLenValidatorAcceptsBoundsByPositionOrKeyword(t *testing.T) {
+	testThatLenValidatorReturnsError(t, "abc", []interface{}{int64(3), int64(5)}, nil, "")
+	testThatLenValidatorReturnsError(t, "ab", []interface{}{int64(3), int64(5)}, nil, "len.cannotBeShorterThan")
+	testThatLenValidatorReturnsError(t, "abcdef", []interface{}{int64(3)}, parser.Keywords{{Name: "max", Value: int64(5)}}, "len.cannotBeLongerThan")
+	testThatLenValidatorReturnsError(t, "abcdef", nil, parser.Keywords{{Name: "min", Value: int64(3)}}, "")
+	testThatLenValidatorReturnsError(t, []string{"a", "b"}, nil, parser.Keywords{{Name: "max", Value: int64(1)}}, "len.cannotContainMoreItemsThan")
+	testThatLenValidatorReturnsError(t, map[string]int{}, nil, parser.Keywords{{Name: "max", Value: int64(2)}, {Name: "min", Value: int64(1)}}, "len.cannotContainLessKeysThan")
+}
+
+func TestThatLenValidatorFailsForInvalidOptions(t *testing.T) {
+	testThatLenValidatorReturnsError(t, "abc", nil, nil, "arguments.oneOrMoreRequired")
+	testThatLenValidatorReturnsError(t, "abc", []interface{}{int64(1), int64(2), int64(3)}, nil, "arguments.invalid")
+	testThatLenValidatorReturnsError(t, "abc", []interface{}{int64(1)}, parser.Keywords{{Name: "min", Value: int64(2)}}, "arguments.invalid")
+	testThatLenValidatorReturnsError(t, "abc", []interface{}{int64(1), "2"}, nil, "arguments.invalidType")
+	testThatLenValidatorReturnsError(t, "abc", nil, parser.Keywords{{Name: "max", Value: "2"}}, "arguments.invalidType")
+	testThatLenValidatorReturnsError(t, 5, []interface{}{int64(1)}, nil, "type.unsupported")
+}
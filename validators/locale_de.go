@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+func registerGermanLocale(lc *core.Locale) {
+	lc.Set("type.unsupported", "Der Validator '{validator}' unterstützt den Typ des Feldes '{field}' nicht.")
+	lc.Set("arguments.invalid", "Die Optionen des Validators '{validator}' für das Feld '{field}' können nicht gelesen werden.")
+	lc.Set("arguments.invalidType", "Der Validator '{validator}' für das Feld '{field}' erwartet für Parameter {position} den Typ {type}.")
+	lc.Set("arguments.noneSupported", "Der Validator '{validator}' für das Feld '{field}' unterstützt keine Argumente.")
+	lc.Set("arguments.singleRequired", "Der Validator '{validator}' für das Feld '{field}' erwartet genau ein Argument.")
+	lc.Set("arguments.oneOrMoreRequired", "Der Validator '{validator}' für das Feld '{field}' erwartet mindestens ein Argument.")
+	lc.Set("not.cannotBeValue", "{field} darf nicht {forbidden} sein.")
+	lc.Set("nil.isNotNil", "{field} ist nicht nil.")
+	lc.Set("empty.isNotEmpty", "{field} ist nicht leer.")
+	lc.Set("notEmpty.cannotBeEmpty", "{field} darf nicht leer sein.")
+	lc.Set("min.cannotBeShorterThan", "{field} darf nicht kürzer als {min, plural, one {# Zeichen} other {# Zeichen}} sein.")
+	lc.Set("min.cannotBeLessThan", "{field} darf nicht kleiner als {min} sein.")
+	lc.Set("min.cannotContainLessItemsThan", "{field} darf nicht weniger als {min, plural, one {# Element} other {# Elemente}} enthalten.")
+	lc.Set("min.cannotContainLessKeysThan", "{field} darf nicht weniger als {min, plural, one {# Schlüssel} other {# Schlüssel}} enthalten.")
+	lc.Set("max.cannotBeLongerThan", "{field} darf nicht länger als {max, plural, one {# Zeichen} other {# Zeichen}} sein.")
+	lc.Set("max.cannotBeGreaterThan", "{field} darf nicht größer als {max} sein.")
+	lc.Set("max.cannotContainMoreItemsThan", "{field} darf nicht mehr als {max, plural, one {# Element} other {# Elemente}} enthalten.")
+	lc.Set("max.cannotContainMoreKeysThan", "{field} darf nicht mehr als {max, plural, one {# Schlüssel} other {# Schlüssel}} enthalten.")
+	lc.Set("len.cannotBeShorterThan", "{field} darf nicht kürzer als {min, plural, one {# Zeichen} other {# Zeichen}} sein.")
+	lc.Set("len.cannotBeLongerThan", "{field} darf nicht länger als {max, plural, one {# Zeichen} other {# Zeichen}} sein.")
+	lc.Set("len.cannotContainLessItemsThan", "{field} darf nicht weniger als {min, plural, one {# Element} other {# Elemente}} enthalten.")
+	lc.Set("len.cannotContainMoreItemsThan", "{field} darf nicht mehr als {max, plural, one {# Element} other {# Elemente}} enthalten.")
+	lc.Set("len.cannotContainLessKeysThan", "{field} darf nicht weniger als {min, plural, one {# Schlüssel} other {# Schlüssel}} enthalten.")
+	lc.Set("len.cannotContainMoreKeysThan", "{field} darf nicht mehr als {max, plural, one {# Schlüssel} other {# Schlüssel}} enthalten.")
+	lc.Set("lowerCase.mustBeLowerCase", "{field} muss in Kleinbuchstaben geschrieben sein.")
+	lc.Set("upperCase.mustBeUpperCase", "{field} muss in Großbuchstaben geschrieben sein.")
+	lc.Set("contain.mustContainValue", "{field} muss einen der folgenden Werte enthalten: '{values}'.")
+	lc.Set("equal.mustEqualValue", "{field} muss einem der folgenden Werte entsprechen: '{values}'.")
+	lc.Set("regexp.mustMatchPattern", "{field} muss dem Muster '{pattern}' entsprechen.")
+	lc.Set("numeric.mustBeNumeric", "{field} muss numerisch sein.")
+	lc.Set("time.mustBeValid", "{field} muss eine gültige Zeitangabe sein.")
+	lc.Set("time.mustBeAfter", "{field} muss nach {after} liegen.")
+	lc.Set("time.mustBeBefore", "{field} muss vor {before} liegen.")
+	lc.Set("negation.mustNotSatisfy", "{field} darf {expression} nicht erfüllen.")
+	lc.Set("contain.negated", "{field} darf '{0}' nicht enthalten.")
+	lc.Set("equal.negated", "{field} darf nicht '{0}' entsprechen.")
+	lc.Set("regexp.negated", "{field} darf nicht dem Muster '{0}' entsprechen.")
+	lc.Set("empty.negated", "{field} darf nicht leer sein.")
+	lc.Set("nil.negated", "{field} darf nicht nil sein.")
+	lc.Set("type.cannotValidateDirectly", "Der Typ '{type}' kann nicht direkt validiert werden.")
+	lc.Set("validator.notRegistered", "Der Validator '{name}' ist nicht registriert.")
+	lc.Set("validator.panicked", "Der Validator '{validator}' für das Feld '{field}' ist abgestürzt: {panic}")
+	lc.Set("func.methodDoesNotExist", "Die Validierungsmethode '{method}' für das Feld '{field}' existiert nicht.")
+	lc.Set("func.invalidParameters", "Die Validierungsmethode '{method}' muss einen core.ValidatorContext und eine Liste von Argumenten annehmen.")
+	lc.Set("func.callFailed", "Die Validierungsmethode '{method}' kann nicht aufgerufen werden.")
+	lc.Set("func.invalidReturnValue", "Ungültige Rückgabewerte der Validierungsmethode '{method}'. Der Rückgabewert muss vom Typ 'error' sein.")
+	lc.Set("regexp.invalidPattern", "Unerwarteter Fehler im regulären Ausdruck für das Feld '{field}': {error}")
+	lc.Set("reference.cannotResolve", "Die Referenz '{reference}' kann nicht aufgelöst werden.")
+	lc.Set("alias.invalidDeclaration", "Die Alias-Deklaration '{declaration}' muss ein Name sein, optional gefolgt von Parameternamen in Klammern.")
+	lc.Set("alias.argumentCount", "Der Alias '{name}' erwartet die Argumente ({params}), hat aber {count} erhalten.")
+	lc.Set("alias.cycle", "Der Alias '{name}' verweist auf sich selbst: {cycle}.")
+	lc.Set("alias.invalidKeyword", "Der Alias '{name}' kann das Schlüsselwortargument '{keyword}' keinem seiner Parameter ({params}) zuordnen.")
+	lc.Set("parser.unexpectedCharacter", "Unerwartetes Zeichen {character} an Position {position}.")
+	lc.Set("parser.unexpectedEnd", "Unerwartetes Ende an Position {position}.")
+	lc.Set("parser.invalidNumber", "Das Argument '{argument}' an Position {position} ist keine gültige Zahl.")
+	lc.Set("parser.invalidBoolean", "Das Argument '{argument}' an Position {position} ist kein gültiger Wahrheitswert.")
+	lc.Set("parser.invalidString", "Ungültige Zeichenkette {string} an Position {position}.")
+	lc.Set("parser.positionalAfterKeyword", "Das Positionsargument an Position {position} muss vor den Schlüsselwortargumenten stehen.")
+	lc.Set("parser.duplicateKeyword", "Das Schlüsselwortargument '{keyword}' an Position {position} wird mehrfach angegeben.")
+	lc.Set("parser.unhandledToken", "Analyse nicht möglich. Unbehandelter Tokentyp.")
+	lc.Set("signature.expectsNone", "Der Validator '{name}' erwartet keine Argumente, hat aber {count} erhalten.")
+	lc.Set("signature.expectsExactly", "Der Validator '{name}' erwartet {required, plural, one {# Argument} other {# Argumente}}, hat aber {count} erhalten.")
+	lc.Set("signature.expectsAtLeast", "Der Validator '{name}' erwartet mindestens {required, plural, one {# Argument} other {# Argumente}}, hat aber {count} erhalten.")
+	lc.Set("signature.expectsBetween", "Der Validator '{name}' erwartet zwischen {required} und {max, plural, one {# Argument} other {# Argumenten}}, hat aber {count} erhalten.")
+	lc.Set("signature.invalidArgumentType", "Der Validator '{name}' erwartet für Argument {position} den Typ {type}.")
+	lc.Set("signature.unknownKeyword", "Der Validator '{name}' hat kein Schlüsselwortargument '{keyword}'.")
+	lc.Set("signature.invalidKeywordType", "Der Validator '{name}' erwartet für das Schlüsselwortargument '{keyword}' den Typ {type}.")
+	lc.Set("syntax.unknownMessageScope", "Die Meldung '{tag}.{scope}' passt zu keiner Methode und keiner Methodengruppe des Feldes.")
+}
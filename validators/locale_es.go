@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+func registerSpanishLocale(lc *core.Locale) {
+	lc.Set("type.unsupported", "El validador '{validator}' no admite el tipo del campo '{field}'.")
+	lc.Set("arguments.invalid", "No se pueden interpretar las opciones del validador '{validator}' para el campo '{field}'.")
+	lc.Set("arguments.invalidType", "El validador '{validator}' del campo '{field}' requiere que el parámetro {position} sea de tipo {type}.")
+	lc.Set("arguments.noneSupported", "El validador '{validator}' del campo '{field}' no admite argumentos.")
+	lc.Set("arguments.singleRequired", "El validador '{validator}' del campo '{field}' requiere un único argumento.")
+	lc.Set("arguments.oneOrMoreRequired", "El validador '{validator}' del campo '{field}' requiere al menos un argumento.")
+	lc.Set("not.cannotBeValue", "{field} no puede ser {forbidden}.")
+	lc.Set("nil.isNotNil", "{field} no es nil.")
+	lc.Set("empty.isNotEmpty", "{field} no está vacío.")
+	lc.Set("notEmpty.cannotBeEmpty", "{field} no puede estar vacío.")
+	lc.Set("min.cannotBeShorterThan", "{field} no puede tener menos de {min, plural, one {# carácter} other {# caracteres}}.")
+	lc.Set("min.cannotBeLessThan", "{field} no puede ser menor que {min}.")
+	lc.Set("min.cannotContainLessItemsThan", "{field} no puede contener menos de {min, plural, one {# elemento} other {# elementos}}.")
+	lc.Set("min.cannotContainLessKeysThan", "{field} no puede contener menos de {min, plural, one {# clave} other {# claves}}.")
+	lc.Set("max.cannotBeLongerThan", "{field} no puede tener más de {max, plural, one {# carácter} other {# caracteres}}.")
+	lc.Set("max.cannotBeGreaterThan", "{field} no puede ser mayor que {max}.")
+	lc.Set("max.cannotContainMoreItemsThan", "{field} no puede contener más de {max, plural, one {# elemento} other {# elementos}}.")
+	lc.Set("max.cannotContainMoreKeysThan", "{field} no puede contener más de {max, plural, one {# clave} other {# claves}}.")
+	lc.Set("len.cannotBeShorterThan", "{field} no puede tener menos de {min, plural, one {# carácter} other {# caracteres}}.")
+	lc.Set("len.cannotBeLongerThan", "{field} no puede tener más de {max, plural, one {# carácter} other {# caracteres}}.")
+	lc.Set("len.cannotContainLessItemsThan", "{field} no puede contener menos de {min, plural, one {# elemento} other {# elementos}}.")
+	lc.Set("len.cannotContainMoreItemsThan", "{field} no puede contener más de {max, plural, one {# elemento} other {# elementos}}.")
+	lc.Set("len.cannotContainLessKeysThan", "{field} no puede contener menos de {min, plural, one {# clave} other {# claves}}.")
+	lc.Set("len.cannotContainMoreKeysThan", "{field} no puede contener más de {max, plural, one {# clave} other {# claves}}.")
+	lc.Set("lowerCase.mustBeLowerCase", "{field} debe estar en minúsculas.")
+	lc.Set("upperCase.mustBeUpperCase", "{field} debe estar en mayúsculas.")
+	lc.Set("contain.mustContainValue", "{field} debe contener uno de los siguientes valores: '{values}'.")
+	lc.Set("equal.mustEqualValue", "{field} debe ser igual a uno de los siguientes valores: '{values}'.")
+	lc.Set("regexp.mustMatchPattern", "{field} debe coincidir con el patrón '{pattern}'.")
+	lc.Set("numeric.mustBeNumeric", "{field} debe ser numérico.")
+	lc.Set("time.mustBeValid", "{field} debe ser una fecha y hora válida.")
+	lc.Set("time.mustBeAfter", "{field} debe ser posterior a {after}.")
+	lc.Set("time.mustBeBefore", "{field} debe ser anterior a {before}.")
+	lc.Set("negation.mustNotSatisfy", "{field} no debe cumplir {expression}.")
+	lc.Set("contain.negated", "{field} no puede contener '{0}'.")
+	lc.Set("equal.negated", "{field} no puede ser igual a '{0}'.")
+	lc.Set("regexp.negated", "{field} no puede coincidir con el patrón '{0}'.")
+	lc.Set("empty.negated", "{field} no puede estar vacío.")
+	lc.Set("nil.negated", "{field} no puede ser nil.")
+	lc.Set("type.cannotValidateDirectly", "No se puede validar directamente el tipo '{type}'.")
+	lc.Set("validator.notRegistered", "El validador '{name}' no está registrado.")
+	lc.Set("validator.panicked", "El validador '{validator}' del campo '{field}' entró en pánico: {panic}")
+	lc.Set("func.methodDoesNotExist", "El método de validación '{method}' del campo '{field}' no existe.")
+	lc.Set("func.invalidParameters", "El método de validación '{method}' debe aceptar un core.ValidatorContext y una lista de argumentos.")
+	lc.Set("func.callFailed", "No se puede llamar al método de validación '{method}'.")
+	lc.Set("func.invalidReturnValue", "Valores de retorno no válidos del método de validación '{method}'. El valor de retorno debe ser de tipo 'error'.")
+	lc.Set("regexp.invalidPattern", "Error inesperado en la expresión regular del campo '{field}': {error}")
+	lc.Set("reference.cannotResolve", "No se puede resolver la referencia '{reference}'.")
+	lc.Set("alias.invalidDeclaration", "La declaración de alias '{declaration}' debe ser un nombre, opcionalmente seguido de nombres de parámetros entre paréntesis.")
+	lc.Set("alias.argumentCount", "El alias '{name}' espera los argumentos ({params}), pero recibió {count}.")
+	lc.Set("alias.cycle", "El alias '{name}' se refiere a sí mismo: {cycle}.")
+	lc.Set("alias.invalidKeyword", "El alias '{name}' no puede asignar el argumento con nombre '{keyword}' a ninguno de sus parámetros ({params}).")
+	lc.Set("parser.unexpectedCharacter", "Carácter inesperado {character} en la posición {position}.")
+	lc.Set("parser.unexpectedEnd", "Final inesperado en la posición {position}.")
+	lc.Set("parser.invalidNumber", "El argumento '{argument}' en la posición {position} no es un número válido.")
+	lc.Set("parser.invalidBoolean", "El argumento '{argument}' en la posición {position} no es un booleano válido.")
+	lc.Set("parser.invalidString", "Cadena no válida {string} en la posición {position}.")
+	lc.Set("parser.positionalAfterKeyword", "El argumento posicional en la posición {position} debe ir antes de los argumentos con nombre.")
+	lc.Set("parser.duplicateKeyword", "El argumento con nombre '{keyword}' en la posición {position} se indica más de una vez.")
+	lc.Set("parser.unhandledToken", "No se puede analizar. Tipo de token no controlado.")
+	lc.Set("signature.expectsNone", "El validador '{name}' no admite argumentos, pero recibió {count}.")
+	lc.Set("signature.expectsExactly", "El validador '{name}' espera {required, plural, one {# argumento} other {# argumentos}}, pero recibió {count}.")
+	lc.Set("signature.expectsAtLeast", "El validador '{name}' espera al menos {required, plural, one {# argumento} other {# argumentos}}, pero recibió {count}.")
+	lc.Set("signature.expectsBetween", "El validador '{name}' espera entre {required} y {max, plural, one {# argumento} other {# argumentos}}, pero recibió {count}.")
+	lc.Set("signature.invalidArgumentType", "El validador '{name}' requiere que el argumento {position} sea de tipo {type}.")
+	lc.Set("signature.unknownKeyword", "El validador '{name}' no tiene el argumento con nombre '{keyword}'.")
+	lc.Set("signature.invalidKeywordType", "El validador '{name}' requiere que el argumento con nombre '{keyword}' sea de tipo {type}.")
+	lc.Set("syntax.unknownMessageScope", "El mensaje '{tag}.{scope}' no corresponde a ningún método ni grupo de métodos del campo.")
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+func registerFrenchLocale(lc *core.Locale) {
+	lc.Set("type.unsupported", "Le validateur '{validator}' ne prend pas en charge le type du champ '{field}'.")
+	lc.Set("arguments.invalid", "Impossible d'analyser les options du validateur '{validator}' pour le champ '{field}'.")
+	lc.Set("arguments.invalidType", "Le validateur '{validator}' du champ '{field}' exige que le paramètre {position} soit de type {type}.")
+	lc.Set("arguments.noneSupported", "Le validateur '{validator}' du champ '{field}' n'accepte aucun argument.")
+	lc.Set("arguments.singleRequired", "Le validateur '{validator}' du champ '{field}' exige un seul argument.")
+	lc.Set("arguments.oneOrMoreRequired", "Le validateur '{validator}' du champ '{field}' exige au moins un argument.")
+	lc.Set("not.cannotBeValue", "{field} ne peut pas être {forbidden}.")
+	lc.Set("nil.isNotNil", "{field} n'est pas nil.")
+	lc.Set("empty.isNotEmpty", "{field} n'est pas vide.")
+	lc.Set("notEmpty.cannotBeEmpty", "{field} ne peut pas être vide.")
+	lc.Set("min.cannotBeShorterThan", "{field} ne peut pas contenir moins de {min, plural, one {# caractère} other {# caractères}}.")
+	lc.Set("min.cannotBeLessThan", "{field} ne peut pas être inférieur à {min}.")
+	lc.Set("min.cannotContainLessItemsThan", "{field} ne peut pas contenir moins de {min, plural, one {# élément} other {# éléments}}.")
+	lc.Set("min.cannotContainLessKeysThan", "{field} ne peut pas contenir moins de {min, plural, one {# clé} other {# clés}}.")
+	lc.Set("max.cannotBeLongerThan", "{field} ne peut pas contenir plus de {max, plural, one {# caractère} other {# caractères}}.")
+	lc.Set("max.cannotBeGreaterThan", "{field} ne peut pas être supérieur à {max}.")
+	lc.Set("max.cannotContainMoreItemsThan", "{field} ne peut pas contenir plus de {max, plural, one {# élément} other {# éléments}}.")
+	lc.Set("max.cannotContainMoreKeysThan", "{field} ne peut pas contenir plus de {max, plural, one {# clé} other {# clés}}.")
+	lc.Set("len.cannotBeShorterThan", "{field} ne peut pas contenir moins de {min, plural, one {# caractère} other {# caractères}}.")
+	lc.Set("len.cannotBeLongerThan", "{field} ne peut pas contenir plus de {max, plural, one {# caractère} other {# caractères}}.")
+	lc.Set("len.cannotContainLessItemsThan", "{field} ne peut pas contenir moins de {min, plural, one {# élément} other {# éléments}}.")
+	lc.Set("len.cannotContainMoreItemsThan", "{field} ne peut pas contenir plus de {max, plural, one {# élément} other {# éléments}}.")
+	lc.Set("len.cannotContainLessKeysThan", "{field} ne peut pas contenir moins de {min, plural, one {# clé} other {# clés}}.")
+	lc.Set("len.cannotContainMoreKeysThan", "{field} ne peut pas contenir plus de {max, plural, one {# clé} other {# clés}}.")
+	lc.Set("lowerCase.mustBeLowerCase", "{field} doit être en minuscules.")
+	lc.Set("upperCase.mustBeUpperCase", "{field} doit être en majuscules.")
+	lc.Set("contain.mustContainValue", "{field} doit contenir l'une des valeurs suivantes : '{values}'.")
+	lc.Set("equal.mustEqualValue", "{field} doit être égal à l'une des valeurs suivantes : '{values}'.")
+	lc.Set("regexp.mustMatchPattern", "{field} doit correspondre au motif '{pattern}'.")
+	lc.Set("numeric.mustBeNumeric", "{field} doit être numérique.")
+	lc.Set("time.mustBeValid", "{field} doit être une date valide.")
+	lc.Set("time.mustBeAfter", "{field} doit être postérieur à {after}.")
+	lc.Set("time.mustBeBefore", "{field} doit être antérieur à {before}.")
+	lc.Set("negation.mustNotSatisfy", "{field} ne doit pas satisfaire {expression}.")
+	lc.Set("contain.negated", "{field} ne peut pas contenir '{0}'.")
+	lc.Set("equal.negated", "{field} ne peut pas être égal à '{0}'.")
+	lc.Set("regexp.negated", "{field} ne peut pas correspondre au motif '{0}'.")
+	lc.Set("empty.negated", "{field} ne peut pas être vide.")
+	lc.Set("nil.negated", "{field} ne peut pas être nil.")
+	lc.Set("type.cannotValidateDirectly", "Impossible de valider directement le type '{type}'.")
+	lc.Set("validator.notRegistered", "Le validateur '{name}' n'est pas enregistré.")
+	lc.Set("validator.panicked", "Le validateur '{validator}' du champ '{field}' a paniqué : {panic}")
+	lc.Set("func.methodDoesNotExist", "La méthode de validation '{method}' du champ '{field}' n'existe pas.")
+	lc.Set("func.invalidParameters", "La méthode de validation '{method}' doit accepter un core.ValidatorContext et une liste d'arguments.")
+	lc.Set("func.callFailed", "Impossible d'appeler la méthode de validation '{method}'.")
+	lc.Set("func.invalidReturnValue", "Valeur(s) de retour invalide(s) de la méthode de validation '{method}'. La valeur de retour doit être de type 'error'.")
+	lc.Set("regexp.invalidPattern", "Erreur inattendue de l'expression régulière du champ '{field}' : {error}")
+	lc.Set("reference.cannotResolve", "La référence '{reference}' ne peut pas être résolue.")
+	lc.Set("alias.invalidDeclaration", "La déclaration d'alias '{declaration}' doit être un nom, éventuellement suivi de noms de paramètres entre parenthèses.")
+	lc.Set("alias.argumentCount", "L'alias '{name}' attend les arguments ({params}), mais en a reçu {count}.")
+	lc.Set("alias.cycle", "L'alias '{name}' fait référence à lui-même : {cycle}.")
+	lc.Set("alias.invalidKeyword", "L'alias '{name}' ne peut associer l'argument nommé '{keyword}' à aucun de ses paramètres ({params}).")
+	lc.Set("parser.unexpectedCharacter", "Caractère inattendu {character} à la position {position}.")
+	lc.Set("parser.unexpectedEnd", "Fin inattendue à la position {position}.")
+	lc.Set("parser.invalidNumber", "L'argument '{argument}' à la position {position} n'est pas un nombre valide.")
+	lc.Set("parser.invalidBoolean", "L'argument '{argument}' à la position {position} n'est pas un booléen valide.")
+	lc.Set("parser.invalidString", "Chaîne invalide {string} à la position {position}.")
+	lc.Set("parser.positionalAfterKeyword", "L'argument positionnel à la position {position} doit précéder les arguments nommés.")
+	lc.Set("parser.duplicateKeyword", "L'argument nommé '{keyword}' à la position {position} est donné plus d'une fois.")
+	lc.Set("parser.unhandledToken", "Analyse impossible. Type de jeton non géré.")
+	lc.Set("signature.expectsNone", "Le validateur '{name}' n'accepte aucun argument, mais en a reçu {count}.")
+	lc.Set("signature.expectsExactly", "Le validateur '{name}' attend {required, plural, one {# argument} other {# arguments}}, mais en a reçu {count}.")
+	lc.Set("signature.expectsAtLeast", "Le validateur '{name}' attend au moins {required, plural, one {# argument} other {# arguments}}, mais en a reçu {count}.")
+	lc.Set("signature.expectsBetween", "Le validateur '{name}' attend entre {required} et {max, plural, one {# argument} other {# arguments}}, mais en a reçu {count}.")
+	lc.Set("signature.invalidArgumentType", "Le validateur '{name}' exige que l'argument {position} soit de type {type}.")
+	lc.Set("signature.unknownKeyword", "Le validateur '{name}' n'a pas d'argument nommé '{keyword}'.")
+	lc.Set("signature.invalidKeywordType", "Le validateur '{name}' exige que l'argument nommé '{keyword}' soit de type {type}.")
+	lc.Set("syntax.unknownMessageScope", "Le message '{tag}.{scope}' ne correspond à aucune méthode ni à aucun groupe de méthodes du champ.")
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+func registerItalianLocale(lc *core.Locale) {
+	lc.Set("type.unsupported", "Il validatore '{validator}' non supporta il tipo del campo '{field}'.")
+	lc.Set("arguments.invalid", "Impossibile interpretare le opzioni del validatore '{validator}' per il campo '{field}'.")
+	lc.Set("arguments.invalidType", "Il validatore '{validator}' del campo '{field}' richiede che il parametro {position} sia di tipo {type}.")
+	lc.Set("arguments.noneSupported", "Il validatore '{validator}' del campo '{field}' non supporta argomenti.")
+	lc.Set("arguments.singleRequired", "Il validatore '{validator}' del campo '{field}' richiede un solo argomento.")
+	lc.Set("arguments.oneOrMoreRequired", "Il validatore '{validator}' del campo '{field}' richiede almeno un argomento.")
+	lc.Set("not.cannotBeValue", "{field} non può essere {forbidden}.")
+	lc.Set("nil.isNotNil", "{field} non è nil.")
+	lc.Set("empty.isNotEmpty", "{field} non è vuoto.")
+	lc.Set("notEmpty.cannotBeEmpty", "{field} non può essere vuoto.")
+	lc.Set("min.cannotBeShorterThan", "{field} non può essere più corto di {min, plural, one {# carattere} other {# caratteri}}.")
+	lc.Set("min.cannotBeLessThan", "{field} non può essere inferiore a {min}.")
+	lc.Set("min.cannotContainLessItemsThan", "{field} non può contenere meno di {min, plural, one {# elemento} other {# elementi}}.")
+	lc.Set("min.cannotContainLessKeysThan", "{field} non può contenere meno di {min, plural, one {# chiave} other {# chiavi}}.")
+	lc.Set("max.cannotBeLongerThan", "{field} non può essere più lungo di {max, plural, one {# carattere} other {# caratteri}}.")
+	lc.Set("max.cannotBeGreaterThan", "{field} non può essere superiore a {max}.")
+	lc.Set("max.cannotContainMoreItemsThan", "{field} non può contenere più di {max, plural, one {# elemento} other {# elementi}}.")
+	lc.Set("max.cannotContainMoreKeysThan", "{field} non può contenere più di {max, plural, one {# chiave} other {# chiavi}}.")
+	lc.Set("len.cannotBeShorterThan", "{field} non può essere più corto di {min, plural, one {# carattere} other {# caratteri}}.")
+	lc.Set("len.cannotBeLongerThan", "{field} non può essere più lungo di {max, plural, one {# carattere} other {# caratteri}}.")
+	lc.Set("len.cannotContainLessItemsThan", "{field} non può contenere meno di {min, plural, one {# elemento} other {# elementi}}.")
+	lc.Set("len.cannotContainMoreItemsThan", "{field} non può contenere più di {max, plural, one {# elemento} other {# elementi}}.")
+	lc.Set("len.cannotContainLessKeysThan", "{field} non può contenere meno di {min, plural, one {# chiave} other {# chiavi}}.")
+	lc.Set("len.cannotContainMoreKeysThan", "{field} non può contenere più di {max, plural, one {# chiave} other {# chiavi}}.")
+	lc.Set("lowerCase.mustBeLowerCase", "{field} deve essere in minuscolo.")
+	lc.Set("upperCase.mustBeUpperCase", "{field} deve essere in maiuscolo.")
+	lc.Set("contain.mustContainValue", "{field} deve contenere uno dei seguenti valori: '{values}'.")
+	lc.Set("equal.mustEqualValue", "{field} deve essere uguale a uno dei seguenti valori: '{values}'.")
+	lc.Set("regexp.mustMatchPattern", "{field} deve corrispondere al modello '{pattern}'.")
+	lc.Set("numeric.mustBeNumeric", "{field} deve essere numerico.")
+	lc.Set("time.mustBeValid", "{field} deve essere un orario valido.")
+	lc.Set("time.mustBeAfter", "{field} deve essere successivo a {after}.")
+	lc.Set("time.mustBeBefore", "{field} deve essere precedente a {before}.")
+	lc.Set("negation.mustNotSatisfy", "{field} non deve soddisfare {expression}.")
+	lc.Set("contain.negated", "{field} non può contenere '{0}'.")
+	lc.Set("equal.negated", "{field} non può essere uguale a '{0}'.")
+	lc.Set("regexp.negated", "{field} non può corrispondere al modello '{0}'.")
+	lc.Set("empty.negated", "{field} non può essere vuoto.")
+	lc.Set("nil.negated", "{field} non può essere nil.")
+	lc.Set("type.cannotValidateDirectly", "Impossibile validare direttamente il tipo '{type}'.")
+	lc.Set("validator.notRegistered", "Il validatore '{name}' non è registrato.")
+	lc.Set("validator.panicked", "Il validatore '{validator}' del campo '{field}' è andato in panico: {panic}")
+	lc.Set("func.methodDoesNotExist", "Il metodo di validazione '{method}' del campo '{field}' non esiste.")
+	lc.Set("func.invalidParameters", "Il metodo di validazione '{method}' deve accettare un core.ValidatorContext e un elenco di argomenti.")
+	lc.Set("func.callFailed", "Impossibile chiamare il metodo di validazione '{method}'.")
+	lc.Set("func.invalidReturnValue", "Valori di ritorno non validi del metodo di validazione '{method}'. Il valore di ritorno deve essere di tipo 'error'.")
+	lc.Set("regexp.invalidPattern", "Errore inatteso nell'espressione regolare del campo '{field}': {error}")
+	lc.Set("reference.cannotResolve", "Impossibile risolvere il riferimento '{reference}'.")
+	lc.Set("alias.invalidDeclaration", "La dichiarazione di alias '{declaration}' deve essere un nome, eventualmente seguito da nomi di parametri tra parentesi.")
+	lc.Set("alias.argumentCount", "L'alias '{name}' si aspetta gli argomenti ({params}), ma ne ha ricevuti {count}.")
+	lc.Set("alias.cycle", "L'alias '{name}' fa riferimento a se stesso: {cycle}.")
+	lc.Set("alias.invalidKeyword", "L'alias '{name}' non può associare l'argomento con nome '{keyword}' a nessuno dei suoi parametri ({params}).")
+	lc.Set("parser.unexpectedCharacter", "Carattere inatteso {character} alla posizione {position}.")
+	lc.Set("parser.unexpectedEnd", "Fine inattesa alla posizione {position}.")
+	lc.Set("parser.invalidNumber", "L'argomento '{argument}' alla posizione {position} non è un numero valido.")
+	lc.Set("parser.invalidBoolean", "L'argomento '{argument}' alla posizione {position} non è un booleano valido.")
+	lc.Set("parser.invalidString", "Stringa non valida {string} alla posizione {position}.")
+	lc.Set("parser.positionalAfterKeyword", "L'argomento posizionale alla posizione {position} deve precedere gli argomenti con nome.")
+	lc.Set("parser.duplicateKeyword", "L'argomento con nome '{keyword}' alla posizione {position} è indicato più di una volta.")
+	lc.Set("parser.unhandledToken", "Impossibile analizzare. Tipo di token non gestito.")
+	lc.Set("signature.expectsNone", "Il validatore '{name}' non accetta argomenti, ma ne ha ricevuti {count}.")
+	lc.Set("signature.expectsExactly", "Il validatore '{name}' richiede {required, plural, one {# argomento} other {# argomenti}}, ma ne ha ricevuti {count}.")
+	lc.Set("signature.expectsAtLeast", "Il validatore '{name}' richiede almeno {required, plural, one {# argomento} other {# argomenti}}, ma ne ha ricevuti {count}.")
+	lc.Set("signature.expectsBetween", "Il validatore '{name}' richiede tra {required} e {max, plural, one {# argomento} other {# argomenti}}, ma ne ha ricevuti {count}.")
+	lc.Set("signature.invalidArgumentType", "Il validatore '{name}' richiede che l'argomento {position} sia di tipo {type}.")
+	lc.Set("signature.unknownKeyword", "Il validatore '{name}' non ha l'argomento con nome '{keyword}'.")
+	lc.Set("signature.invalidKeywordType", "Il validatore '{name}' richiede che l'argomento con nome '{keyword}' sia di tipo {type}.")
+	lc.Set("syntax.unknownMessageScope", "Il messaggio '{tag}.{scope}' non corrisponde a nessun metodo o gruppo di metodi del campo.")
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+func registerDutchLocale(lc *core.Locale) {
+	lc.Set("type.unsupported", "Validator '{validator}' ondersteunt het type van veld '{field}' niet.")
+	lc.Set("arguments.invalid", "De opties van validator '{validator}' voor veld '{field}' kunnen niet worden gelezen.")
+	lc.Set("arguments.invalidType", "Validator '{validator}' op veld '{field}' vereist dat parameter {position} van het type {type} is.")
+	lc.Set("arguments.noneSupported", "Validator '{validator}' op veld '{field}' ondersteunt geen argumenten.")
+	lc.Set("arguments.singleRequired", "Validator '{validator}' op veld '{field}' vereist precies één argument.")
+	lc.Set("arguments.oneOrMoreRequired", "Validator '{validator}' op veld '{field}' vereist ten minste één argument.")
+	lc.Set("not.cannotBeValue", "{field} mag niet {forbidden} zijn.")
+	lc.Set("nil.isNotNil", "{field} is niet nil.")
+	lc.Set("empty.isNotEmpty", "{field} is niet leeg.")
+	lc.Set("notEmpty.cannotBeEmpty", "{field} mag niet leeg zijn.")
+	lc.Set("min.cannotBeShorterThan", "{field} mag niet korter zijn dan {min, plural, one {# teken} other {# tekens}}.")
+	lc.Set("min.cannotBeLessThan", "{field} mag niet kleiner zijn dan {min}.")
+	lc.Set("min.cannotContainLessItemsThan", "{field} mag niet minder dan {min, plural, one {# item} other {# items}} bevatten.")
+	lc.Set("min.cannotContainLessKeysThan", "{field} mag niet minder dan {min, plural, one {# sleutel} other {# sleutels}} bevatten.")
+	lc.Set("max.cannotBeLongerThan", "{field} mag niet langer zijn dan {max, plural, one {# teken} other {# tekens}}.")
+	lc.Set("max.cannotBeGreaterThan", "{field} mag niet groter zijn dan {max}.")
+	lc.Set("max.cannotContainMoreItemsThan", "{field} mag niet meer dan {max, plural, one {# item} other {# items}} bevatten.")
+	lc.Set("max.cannotContainMoreKeysThan", "{field} mag niet meer dan {max, plural, one {# sleutel} other {# sleutels}} bevatten.")
+	lc.Set("len.cannotBeShorterThan", "{field} mag niet korter zijn dan {min, plural, one {# teken} other {# tekens}}.")
+	lc.Set("len.cannotBeLongerThan", "{field} mag niet langer zijn dan {max, plural, one {# teken} other {# tekens}}.")
+	lc.Set("len.cannotContainLessItemsThan", "{field} mag niet minder dan {min, plural, one {# item} other {# items}} bevatten.")
+	lc.Set("len.cannotContainMoreItemsThan", "{field} mag niet meer dan {max, plural, one {# item} other {# items}} bevatten.")
+	lc.Set("len.cannotContainLessKeysThan", "{field} mag niet minder dan {min, plural, one {# sleutel} other {# sleutels}} bevatten.")
+	lc.Set("len.cannotContainMoreKeysThan", "{field} mag niet meer dan {max, plural, one {# sleutel} other {# sleutels}} bevatten.")
+	lc.Set("lowerCase.mustBeLowerCase", "{field} moet in kleine letters zijn.")
+	lc.Set("upperCase.mustBeUpperCase", "{field} moet in hoofdletters zijn.")
+	lc.Set("contain.mustContainValue", "{field} moet een van de volgende waarden bevatten: '{values}'.")
+	lc.Set("equal.mustEqualValue", "{field} moet gelijk zijn aan een van de volgende waarden: '{values}'.")
+	lc.Set("regexp.mustMatchPattern", "{field} moet overeenkomen met het patroon '{pattern}'.")
+	lc.Set("numeric.mustBeNumeric", "{field} moet numeriek zijn.")
+	lc.Set("time.mustBeValid", "{field} moet een geldige tijd zijn.")
+	lc.Set("time.mustBeAfter", "{field} moet na {after} liggen.")
+	lc.Set("time.mustBeBefore", "{field} moet vóór {before} liggen.")
+	lc.Set("negation.mustNotSatisfy", "{field} mag niet voldoen aan {expression}.")
+	lc.Set("contain.negated", "{field} mag '{0}' niet bevatten.")
+	lc.Set("equal.negated", "{field} mag niet gelijk zijn aan '{0}'.")
+	lc.Set("regexp.negated", "{field} mag niet overeenkomen met het patroon '{0}'.")
+	lc.Set("empty.negated", "{field} mag niet leeg zijn.")
+	lc.Set("nil.negated", "{field} mag niet nil zijn.")
+	lc.Set("type.cannotValidateDirectly", "Het type '{type}' kan niet direct worden gevalideerd.")
+	lc.Set("validator.notRegistered", "De validator '{name}' is niet geregistreerd.")
+	lc.Set("validator.panicked", "De validator '{validator}' van het veld '{field}' is gecrasht: {panic}")
+	lc.Set("func.methodDoesNotExist", "De validatiemethode '{method}' van het veld '{field}' bestaat niet.")
+	lc.Set("func.invalidParameters", "De validatiemethode '{method}' moet een core.ValidatorContext en een lijst met argumenten accepteren.")
+	lc.Set("func.callFailed", "De validatiemethode '{method}' kan niet worden aangeroepen.")
+	lc.Set("func.invalidReturnValue", "Ongeldige retourwaarde(n) van de validatiemethode '{method}'. De retourwaarde moet van het type 'error' zijn.")
+	lc.Set("regexp.invalidPattern", "Onverwachte fout in de reguliere expressie van het veld '{field}': {error}")
+	lc.Set("reference.cannotResolve", "De verwijzing '{reference}' kan niet worden opgelost.")
+	lc.Set("alias.invalidDeclaration", "De aliasdeclaratie '{declaration}' moet een naam zijn, eventueel gevolgd door parameternamen tussen haakjes.")
+	lc.Set("alias.argumentCount", "De alias '{name}' verwacht de argumenten ({params}), maar kreeg er {count}.")
+	lc.Set("alias.cycle", "De alias '{name}' verwijst naar zichzelf: {cycle}.")
+	lc.Set("alias.invalidKeyword", "Alias '{name}' kan het benoemde argument '{keyword}' aan geen van zijn parameters ({params}) koppelen.")
+	lc.Set("parser.unexpectedCharacter", "Onverwacht teken {character} op positie {position}.")
+	lc.Set("parser.unexpectedEnd", "Onverwacht einde op positie {position}.")
+	lc.Set("parser.invalidNumber", "Het argument '{argument}' op positie {position} is geen geldig getal.")
+	lc.Set("parser.invalidBoolean", "Het argument '{argument}' op positie {position} is geen geldige booleaanse waarde.")
+	lc.Set("parser.invalidString", "Ongeldige tekenreeks {string} op positie {position}.")
+	lc.Set("parser.positionalAfterKeyword", "Het positionele argument op positie {position} moet vóór de benoemde argumenten staan.")
+	lc.Set("parser.duplicateKeyword", "Het benoemde argument '{keyword}' op positie {position} wordt meer dan eens opgegeven.")
+	lc.Set("parser.unhandledToken", "Kan niet analyseren. Onbekend tokentype.")
+	lc.Set("signature.expectsNone", "De validator '{name}' verwacht geen argumenten, maar kreeg er {count}.")
+	lc.Set("signature.expectsExactly", "De validator '{name}' verwacht {required, plural, one {# argument} other {# argumenten}}, maar kreeg er {count}.")
+	lc.Set("signature.expectsAtLeast", "De validator '{name}' verwacht minstens {required, plural, one {# argument} other {# argumenten}}, maar kreeg er {count}.")
+	lc.Set("signature.expectsBetween", "De validator '{name}' verwacht tussen {required} en {max, plural, one {# argument} other {# argumenten}}, maar kreeg er {count}.")
+	lc.Set("signature.invalidArgumentType", "De validator '{name}' vereist dat argument {position} van het type {type} is.")
+	lc.Set("signature.unknownKeyword", "Validator '{name}' heeft geen benoemd argument '{keyword}'.")
+	lc.Set("signature.invalidKeywordType", "Validator '{name}' vereist dat het benoemde argument '{keyword}' van het type {type} is.")
+	lc.Set("syntax.unknownMessageScope", "Het bericht '{tag}.{scope}' komt niet overeen met een methode of methodegroep van het veld.")
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+func registerPortugueseLocale(lc *core.Locale) {
+	lc.Set("type.unsupported", "O validador '{validator}' não suporta o tipo do campo '{field}'.")
+	lc.Set("arguments.invalid", "Não foi possível interpretar as opções do validador '{validator}' para o campo '{field}'.")
+	lc.Set("arguments.invalidType", "O validador '{validator}' do campo '{field}' exige que o parâmetro {position} seja do tipo {type}.")
+	lc.Set("arguments.noneSupported", "O validador '{validator}' do campo '{field}' não aceita argumentos.")
+	lc.Set("arguments.singleRequired", "O validador '{validator}' do campo '{field}' exige um único argumento.")
+	lc.Set("arguments.oneOrMoreRequired", "O validador '{validator}' do campo '{field}' exige pelo menos um argumento.")
+	lc.Set("not.cannotBeValue", "{field} não pode ser {forbidden}.")
+	lc.Set("nil.isNotNil", "{field} não é nil.")
+	lc.Set("empty.isNotEmpty", "{field} não está vazio.")
+	lc.Set("notEmpty.cannotBeEmpty", "{field} não pode estar vazio.")
+	lc.Set("min.cannotBeShorterThan", "{field} não pode ter menos de {min, plural, one {# caractere} other {# caracteres}}.")
+	lc.Set("min.cannotBeLessThan", "{field} não pode ser menor que {min}.")
+	lc.Set("min.cannotContainLessItemsThan", "{field} não pode conter menos de {min, plural, one {# item} other {# itens}}.")
+	lc.Set("min.cannotContainLessKeysThan", "{field} não pode conter menos de {min, plural, one {# chave} other {# chaves}}.")
+	lc.Set("max.cannotBeLongerThan", "{field} não pode ter mais de {max, plural, one {# caractere} other {# caracteres}}.")
+	lc.Set("max.cannotBeGreaterThan", "{field} não pode ser maior que {max}.")
+	lc.Set("max.cannotContainMoreItemsThan", "{field} não pode conter mais de {max, plural, one {# item} other {# itens}}.")
+	lc.Set("max.cannotContainMoreKeysThan", "{field} não pode conter mais de {max, plural, one {# chave} other {# chaves}}.")
+	lc.Set("len.cannotBeShorterThan", "{field} não pode ter menos de {min, plural, one {# caractere} other {# caracteres}}.")
+	lc.Set("len.cannotBeLongerThan", "{field} não pode ter mais de {max, plural, one {# caractere} other {# caracteres}}.")
+	lc.Set("len.cannotContainLessItemsThan", "{field} não pode conter menos de {min, plural, one {# item} other {# itens}}.")
+	lc.Set("len.cannotContainMoreItemsThan", "{field} não pode conter mais de {max, plural, one {# item} other {# itens}}.")
+	lc.Set("len.cannotContainLessKeysThan", "{field} não pode conter menos de {min, plural, one {# chave} other {# chaves}}.")
+	lc.Set("len.cannotContainMoreKeysThan", "{field} não pode conter mais de {max, plural, one {# chave} other {# chaves}}.")
+	lc.Set("lowerCase.mustBeLowerCase", "{field} deve estar em letras minúsculas.")
+	lc.Set("upperCase.mustBeUpperCase", "{field} deve estar em letras maiúsculas.")
+	lc.Set("contain.mustContainValue", "{field} deve conter um dos seguintes valores: '{values}'.")
+	lc.Set("equal.mustEqualValue", "{field} deve ser igual a um dos seguintes valores: '{values}'.")
+	lc.Set("regexp.mustMatchPattern", "{field} deve corresponder ao padrão '{pattern}'.")
+	lc.Set("numeric.mustBeNumeric", "{field} deve ser numérico.")
+	lc.Set("time.mustBeValid", "{field} deve ser uma data/hora válida.")
+	lc.Set("time.mustBeAfter", "{field} deve ser posterior a {after}.")
+	lc.Set("time.mustBeBefore", "{field} deve ser anterior a {before}.")
+	lc.Set("negation.mustNotSatisfy", "{field} não deve satisfazer {expression}.")
+	lc.Set("contain.negated", "{field} não pode conter '{0}'.")
+	lc.Set("equal.negated", "{field} não pode ser igual a '{0}'.")
+	lc.Set("regexp.negated", "{field} não pode corresponder ao padrão '{0}'.")
+	lc.Set("empty.negated", "{field} não pode estar vazio.")
+	lc.Set("nil.negated", "{field} não pode ser nil.")
+	lc.Set("type.cannotValidateDirectly", "Não é possível validar diretamente o tipo '{type}'.")
+	lc.Set("validator.notRegistered", "O validador '{name}' não está registrado.")
+	lc.Set("validator.panicked", "O validador '{validator}' do campo '{field}' entrou em pânico: {panic}")
+	lc.Set("func.methodDoesNotExist", "O método de validação '{method}' do campo '{field}' não existe.")
+	lc.Set("func.invalidParameters", "O método de validação '{method}' deve aceitar um core.ValidatorContext e uma lista de argumentos.")
+	lc.Set("func.callFailed", "Não é possível chamar o método de validação '{method}'.")
+	lc.Set("func.invalidReturnValue", "Valor(es) de retorno inválido(s) do método de validação '{method}'. O valor de retorno deve ser do tipo 'error'.")
+	lc.Set("regexp.invalidPattern", "Erro inesperado na expressão regular do campo '{field}': {error}")
+	lc.Set("reference.cannotResolve", "Não é possível resolver a referência '{reference}'.")
+	lc.Set("alias.invalidDeclaration", "A declaração de alias '{declaration}' deve ser um nome, opcionalmente seguido de nomes de parâmetros entre parênteses.")
+	lc.Set("alias.argumentCount", "O alias '{name}' espera os argumentos ({params}), mas recebeu {count}.")
+	lc.Set("alias.cycle", "O alias '{name}' refere-se a si mesmo: {cycle}.")
+	lc.Set("alias.invalidKeyword", "O alias '{name}' não pode associar o argumento nomeado '{keyword}' a nenhum dos seus parâmetros ({params}).")
+	lc.Set("parser.unexpectedCharacter", "Caractere inesperado {character} na posição {position}.")
+	lc.Set("parser.unexpectedEnd", "Fim inesperado na posição {position}.")
+	lc.Set("parser.invalidNumber", "O argumento '{argument}' na posição {position} não é um número válido.")
+	lc.Set("parser.invalidBoolean", "O argumento '{argument}' na posição {position} não é um booleano válido.")
+	lc.Set("parser.invalidString", "String inválida {string} na posição {position}.")
+	lc.Set("parser.positionalAfterKeyword", "O argumento posicional na posição {position} deve vir antes dos argumentos nomeados.")
+	lc.Set("parser.duplicateKeyword", "O argumento nomeado '{keyword}' na posição {position} é informado mais de uma vez.")
+	lc.Set("parser.unhandledToken", "Não é possível analisar. Tipo de token não tratado.")
+	lc.Set("signature.expectsNone", "O validador '{name}' não aceita argumentos, mas recebeu {count}.")
+	lc.Set("signature.expectsExactly", "O validador '{name}' espera {required, plural, one {# argumento} other {# argumentos}}, mas recebeu {count}.")
+	lc.Set("signature.expectsAtLeast", "O validador '{name}' espera pelo menos {required, plural, one {# argumento} other {# argumentos}}, mas recebeu {count}.")
+	lc.Set("signature.expectsBetween", "O validador '{name}' espera entre {required} e {max, plural, one {# argumento} other {# argumentos}}, mas recebeu {count}.")
+	lc.Set("signature.invalidArgumentType", "O validador '{name}' exige que o argumento {position} seja do tipo {type}.")
+	lc.Set("signature.unknownKeyword", "O validador '{name}' não tem o argumento nomeado '{keyword}'.")
+	lc.Set("signature.invalidKeywordType", "O validador '{name}' exige que o argumento nomeado '{keyword}' seja do tipo {type}.")
+	lc.Set("syntax.unknownMessageScope", "A mensagem '{tag}.{scope}' não corresponde a nenhum método ou grupo de métodos do campo.")
+}
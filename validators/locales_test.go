@@ -0,0 +1,27 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatDefaultLocalesContainAllDefaultMessages(t *testing.T) {
+	english := core.NewLocale()
+	RegisterDefaultLocale(english)
+
+	bundle := core.NewLocaleBundle("en")
+	RegisterDefaultLocales(bundle)
+
+	if len(bundle.Tags()) < 5 {
+		t.Fatalf("Expected several languages, but got %v.", bundle.Tags())
+	}
+
+	for _, tag := range bundle.Tags() {
+		for _, key := range english.Keys() {
+			if _, err := bundle.Locale(tag).Get(key); err != nil {
+				t.Fatalf("Expected locale '%s' to contain '%s', but it didn't.", tag, key)
+			}
+		}
+	}
+}
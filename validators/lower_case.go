@@ -7,7 +7,7 @@ import (
 
 func LowerCaseValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) > 0 {
-		return context.NewError("arguments.noneSupported")
+		return context.NewConfigError("arguments.noneSupported")
 	}
 
 	switch typedValue := context.Value().(type) {
@@ -7,42 +7,37 @@ import (
 
 func MaxValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) != 1 {
-		return context.NewError("arguments.singleRequired")
+		return context.NewConfigError("arguments.singleRequired")
 	}
 
-	if maxValue, ok := args[0].(float64); ok {
+	if maxValue := args[0]; core.IsNumber(maxValue) {
 		switch typedValue := context.Value().(type) {
 		case string:
-			if !context.IsNil() && len(typedValue) > int(maxValue) {
-				return context.NewError("max.cannotBeLongerThan", maxValue)
+			if !context.IsNil() && compareNumber(int64(len(typedValue)), maxValue) > 0 {
+				return context.NewError("max.cannotBeLongerThan", core.Params{"max": maxValue})
 			}
 			return nil
-		case int64:
-			if !context.IsNil() && typedValue > int64(maxValue) {
-				return context.NewError("max.cannotBeGreaterThan", maxValue)
-			}
-			return nil
-		case float64:
-			if !context.IsNil() && typedValue > maxValue {
-				return context.NewError("max.cannotBeGreaterThan", maxValue)
+		case int64, float64:
+			if !context.IsNil() && compareNumber(typedValue, maxValue) > 0 {
+				return context.NewError("max.cannotBeGreaterThan", core.Params{"max": maxValue})
 			}
 			return nil
 		}
 
 		switch context.OriginalKind() {
 		case reflect.Array, reflect.Slice:
-			if reflect.ValueOf(context.Value()).Len() > int(maxValue) {
-				return context.NewError("max.cannotContainMoreItemsThan", maxValue)
+			if compareNumber(int64(reflect.ValueOf(context.Value()).Len()), maxValue) > 0 {
+				return context.NewError("max.cannotContainMoreItemsThan", core.Params{"max": maxValue})
 			}
 			return nil
 		case reflect.Map:
-			if len(reflect.ValueOf(context.Value()).MapKeys()) > int(maxValue) {
-				return context.NewError("max.cannotContainMoreKeysThan", maxValue)
+			if compareNumber(int64(reflect.ValueOf(context.Value()).Len()), maxValue) > 0 {
+				return context.NewError("max.cannotContainMoreKeysThan", core.Params{"max": maxValue})
 			}
 			return nil
 		}
 	} else {
-		return context.NewError("arguments.invalidType", 1, "number")
+		return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "number"})
 	}
 
 	return context.NewError("type.unsupported")
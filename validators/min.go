@@ -7,42 +7,37 @@ import (
 
 func MinValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) != 1 {
-		return context.NewError("arguments.singleRequired")
+		return context.NewConfigError("arguments.singleRequired")
 	}
 
-	if minValue, ok := args[0].(float64); ok {
+	if minValue := args[0]; core.IsNumber(minValue) {
 		switch typedValue := context.Value().(type) {
 		case string:
-			if context.IsNil() || len(typedValue) < int(minValue) {
-				return context.NewError("min.cannotBeShorterThan", minValue)
+			if context.IsNil() || compareNumber(int64(len(typedValue)), minValue) < 0 {
+				return context.NewError("min.cannotBeShorterThan", core.Params{"min": minValue})
 			}
 			return nil
-		case int64:
-			if context.IsNil() || typedValue < int64(minValue) {
-				return context.NewError("min.cannotBeLessThan", minValue)
-			}
-			return nil
-		case float64:
-			if context.IsNil() || typedValue < minValue {
-				return context.NewError("min.cannotBeLessThan", minValue)
+		case int64, float64:
+			if context.IsNil() || compareNumber(typedValue, minValue) < 0 {
+				return context.NewError("min.cannotBeLessThan", core.Params{"min": minValue})
 			}
 			return nil
 		}
 
 		switch context.OriginalKind() {
 		case reflect.Array, reflect.Slice:
-			if reflect.ValueOf(context.Value()).Len() < int(minValue) {
-				return context.NewError("min.cannotContainLessItemsThan", minValue)
+			if compareNumber(int64(reflect.ValueOf(context.Value()).Len()), minValue) < 0 {
+				return context.NewError("min.cannotContainLessItemsThan", core.Params{"min": minValue})
 			}
 			return nil
 		case reflect.Map:
-			if len(reflect.ValueOf(context.Value()).MapKeys()) < int(minValue) {
-				return context.NewError("min.cannotContainLessKeysThan", minValue)
+			if compareNumber(int64(reflect.ValueOf(context.Value()).Len()), minValue) < 0 {
+				return context.NewError("min.cannotContainLessKeysThan", core.Params{"min": minValue})
 			}
 			return nil
 		}
 	} else {
-		return context.NewError("arguments.invalidType", 1, "number")
+		return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "number"})
 	}
 
 	return context.NewError("type.unsupported")
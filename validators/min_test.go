@@ -4,6 +4,8 @@ import (
 	"errors"
 	"github.com/typerandom/validator/core"
 	. "github.com/typerandom/validator/validators"
+	"math"
+	"math/big"
 	"testing"
 )
 
@@ -139,3 +141,28 @@ func TestThatMinValidatorFailsForUnsupportedType(t *testing.T) {
 	type Dummy struct{}
 	testThatMinValidatorFailsForValueUnderLimit(t, 5, &Dummy{}, "type.unsupported")
 }
+
+func TestThatMinValidatorComparesLimitsOfAnyNumericTypeExactly(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-100000000000000000000", 10)
+
+	tests := []struct {
+		limit       interface{}
+		dummy       interface{}
+		expectedErr string
+	}{
+		{int64(5), 5, ""},
+		{int64(5), "abcd", "min.cannotBeShorterThan"},
+		{float64(1.5), 1, "min.cannotBeLessThan"},
+		{int64(math.MaxInt64), int64(math.MaxInt64 - 1), "min.cannotBeLessThan"},
+		{uint64(math.MaxInt64 + 1), int64(math.MaxInt64), "min.cannotBeLessThan"},
+		{huge, int64(math.MinInt64), ""},
+	}
+
+	for _, test := range tests {
+		err := MinValidator(core.NewTestContext(test.dummy), []interface{}{test.limit})
+
+		if (err == nil && test.expectedErr != "") || (err != nil && err.Error() != test.expectedErr) {
+			t.Fatalf("Expected '%s' for %v with limit %v, but got '%v'.", test.expectedErr, test.dummy, test.limit, err)
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 
 func NilValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) > 0 {
-		return context.NewError("arguments.noneSupported")
+		return context.NewConfigError("arguments.noneSupported")
 	}
 
 	if context.IsNil() {
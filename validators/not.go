@@ -5,41 +5,47 @@ import (
 	"github.com/typerandom/validator/core"
 )
 
+// forbids indicates whether or not an argument of not forbids the value of the context, and whether or not the
+// argument can be compared to the value at all. Returns the forbidden value as it's reported.
+func forbids(context core.ValidatorContext, argument interface{}) (interface{}, bool, bool) {
+	if context.IsNil() {
+		return "'nil'", argument == nil, true
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		return fmt.Sprintf("'%v'", typedValue), typedValue == fmt.Sprintf("%v", argument), true
+	case int64, float64:
+		if core.IsNumber(argument) {
+			comparison, ok := core.CompareNumbers(typedValue, argument)
+			return typedValue, ok && comparison == 0, true
+		}
+	}
+
+	return nil, false, false
+}
+
 func NotValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) != 1 {
-		return context.NewError("arguments.singleRequired")
+		return context.NewConfigError("arguments.singleRequired")
 	}
 
-	var argument = args[0]
+	isSupported := false
 
-	if context.IsNil() {
-		if argument == nil {
-			return context.NewError("not.cannotBeValue", "'nil'")
+	// Arguments of other types than the value are skipped, unless none of them can be compared to it.
+	for _, argument := range argumentValues(args[0]) {
+		forbidden, isForbidden, ok := forbids(context, argument)
+
+		if isForbidden {
+			return context.NewError("not.cannotBeValue", core.Params{"forbidden": forbidden})
 		}
-		return nil
+
+		isSupported = isSupported || ok
 	}
 
-	switch typedValue := context.Value().(type) {
-	case string:
-		if typedValue == fmt.Sprintf("%v", argument) {
-			return context.NewError("not.cannotBeValue", fmt.Sprintf("'%v'", typedValue))
-		}
-		return nil
-	case int64:
-		if typedArgument, ok := argument.(float64); ok {
-			if float64(typedValue) == typedArgument {
-				return context.NewError("not.cannotBeValue", typedValue)
-			}
-			return nil
-		}
-	case float64:
-		if typedArgument, ok := argument.(float64); ok {
-			if typedValue == typedArgument {
-				return context.NewError("not.cannotBeValue", typedValue)
-			}
-			return nil
-		}
+	if !isSupported {
+		return context.NewError("type.unsupported")
 	}
 
-	return context.NewError("type.unsupported")
+	return nil
 }
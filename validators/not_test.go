@@ -3,6 +3,7 @@ package validators_test
 import (
 	"errors"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	. "github.com/typerandom/validator/validators"
 	"testing"
 )
@@ -99,3 +100,13 @@ func TestThatNotValidatorFailsWhenValueIsUnsupported(t *testing.T) {
 	testThatNotValidatorFailsWhenValueIsArgumentNotValue(t, float64(123), "abc", "type.unsupported")
 	testThatNotValidatorFailsWhenValueIsArgumentNotValue(t, int64(123), "abc", "type.unsupported")
 }
+
+func TestThatNotValidatorFailsWhenValueIsInArgumentList(t *testing.T) {
+	forbidden := parser.List{"abc", float64(123)}
+
+	testThatNotValidatorFailsWhenValueIsArgumentNotValue(t, "abc", forbidden, "not.cannotBeValue")
+	testThatNotValidatorFailsWhenValueIsArgumentNotValue(t, int64(123), forbidden, "not.cannotBeValue")
+	testThatNotValidatorSucceedsWhenValueIsNotArgumentNotValue(t, "def", forbidden)
+	testThatNotValidatorSucceedsWhenValueIsNotArgumentNotValue(t, float64(456), forbidden)
+	testThatNotValidatorFailsWhenValueIsArgumentNotValue(t, int64(123), parser.List{"abc"}, "type.unsupported")
+}
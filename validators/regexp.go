@@ -1,7 +1,6 @@
 package validators
 
 import (
-	"errors"
 	"github.com/typerandom/validator/core"
 	"regexp"
 )
@@ -12,13 +11,13 @@ var (
 
 func RegexpValidator(context core.ValidatorContext, args []interface{}) error {
 	if len(args) != 1 {
-		return context.NewError("arguments.singleRequired")
+		return context.NewConfigError("arguments.singleRequired")
 	}
 
 	if pattern, ok := args[0].(string); ok {
 		if testValue, ok := context.Value().(string); ok {
 			if context.IsNil() {
-				return context.NewError("regexp.mustMatchPattern", pattern)
+				return context.NewError("regexp.mustMatchPattern", core.Params{"pattern": pattern})
 			}
 
 			var expr *regexp.Regexp
@@ -29,7 +28,7 @@ func RegexpValidator(context core.ValidatorContext, args []interface{}) error {
 				newExpr, err := regexp.Compile(pattern)
 
 				if err != nil {
-					return errors.New("Unexpected regexp error for validator field '{field}': " + err.Error())
+					return core.NewConfigError(core.NewMessage("regexp.invalidPattern", "Unexpected regexp error for validator field '{field}': {error}", testValue, core.Params{"pattern": pattern, "error": err.Error()}))
 				}
 
 				expr = newExpr
@@ -37,13 +36,13 @@ func RegexpValidator(context core.ValidatorContext, args []interface{}) error {
 			}
 
 			if !expr.MatchString(testValue) {
-				return context.NewError("regexp.mustMatchPattern", pattern)
+				return context.NewError("regexp.mustMatchPattern", core.Params{"pattern": pattern})
 			}
 
 			return nil
 		}
 	} else {
-		return context.NewError("arguments.invalidType", 1, "string")
+		return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "string"})
 	}
 
 	return context.NewError("type.unsupported")
@@ -5,14 +5,26 @@ import (
 	"time"
 )
 
+// TimeValidator parses strings as times in a layout, which is given by position or as the keyword argument layout,
+// i.e. "time(´2006-01-02´)" or "time(layout=´2006-01-02´)". The keyword arguments after and before bound the time,
+// either by ´now´ or by a time in the layout, i.e. "time(layout=´2006-01-02´, after=´now´)".
 func TimeValidator(context core.ValidatorContext, args []interface{}) error {
+	arguments := validatorArguments(context, args)
+	layoutArg, hasLayout := arguments.Get(0, "layout")
+
+	layoutCount := len(args)
+
+	if _, ok := arguments.Keyword("layout"); ok {
+		layoutCount++
+	}
+
 	switch typedValue := context.Value().(type) {
 	case string:
-		if len(args) != 1 {
-			return context.NewError("arguments.singleRequired")
+		if !hasLayout || layoutCount != 1 {
+			return context.NewConfigError("arguments.singleRequired")
 		}
 
-		if layout, ok := args[0].(string); ok {
+		if layout, ok := layoutArg.(string); ok {
 			value, err := time.Parse(layout, typedValue)
 
 			if err != nil {
@@ -23,13 +35,65 @@ func TimeValidator(context core.ValidatorContext, args []interface{}) error {
 				return err
 			}
 
-			return nil
+			return checkTimeBounds(context, arguments, layout, value)
 		} else {
-			return context.NewError("arguments.invalidType", 1, "string")
+			return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "string"})
 		}
 	case time.Time:
-		return nil
+		if layoutCount > 1 {
+			return context.NewConfigError("arguments.singleRequired")
+		}
+
+		// Times don't need to be parsed, the layout is only needed for the bounds.
+		layout := time.RFC3339
+
+		if hasLayout {
+			if typedLayout, ok := layoutArg.(string); ok {
+				layout = typedLayout
+			} else {
+				return context.NewConfigError("arguments.invalidType", core.Params{"position": 1, "type": "string"})
+			}
+		}
+
+		return checkTimeBounds(context, arguments, layout, typedValue)
 	}
 
 	return context.NewError("type.unsupported")
 }
+
+// checkTimeBounds checks a time against the after and before keyword arguments.
+func checkTimeBounds(context core.ValidatorContext, arguments *core.Arguments, layout string, value time.Time) error {
+	for _, name := range []string{"after", "before"} {
+		boundArg, ok := arguments.Keyword(name)
+
+		if !ok {
+			continue
+		}
+
+		bound, ok := boundArg.(string)
+
+		if !ok {
+			return context.NewConfigError("arguments.invalidType", core.Params{"position": name, "type": "string"})
+		}
+
+		boundTime := time.Now()
+
+		if bound != "now" {
+			var err error
+
+			if boundTime, err = time.Parse(layout, bound); err != nil {
+				return context.NewConfigError("arguments.invalid")
+			}
+		}
+
+		if name == "after" && !value.After(boundTime) {
+			return context.NewError("time.mustBeAfter", core.Params{"after": bound})
+		}
+
+		if name == "before" && !value.Before(boundTime) {
+			return context.NewError("time.mustBeBefore", core.Params{"before": bound})
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package validators_test
 
 import (
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	. "github.com/typerandom/validator/validators"
 	"testing"
 	"time"
@@ -98,3 +99,60 @@ func TestThatTimeValidatorFailsForUnsupportedValueType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatTimeValidatorAcceptsLayoutAsKeywordArgument(t *testing.T) {
+	ctx := core.NewTestContext("2013-06-05")
+	ctx.SetArguments(core.NewArguments(nil, parser.Keywords{{Name: "layout", Value: "2006-01-02"}}))
+
+	if err := TimeValidator(ctx, nil); err != nil {
+		t.Fatalf("Didn't expect error, but got %s.", err)
+	}
+
+	if _, ok := ctx.Value().(time.Time); !ok {
+		t.Fatalf("Expected value to be converted to time.Time.")
+	}
+
+	ctx = core.NewTestContext("2013-06-05")
+	ctx.SetArguments(core.NewArguments(nil, parser.Keywords{{Name: "layout", Value: "2006-01-02"}}))
+
+	if err := TimeValidator(ctx, []interface{}{"2006"}); err == nil || err.Error() != "arguments.singleRequired" {
+		t.Fatalf("Expected single argument required error, got %v.", err)
+	}
+}
+
+func testThatTimeValidatorChecksBounds(t *testing.T, value interface{}, keywords parser.Keywords, expectedErr string) {
+	ctx := core.NewTestContext(value)
+	ctx.SetArguments(core.NewArguments(nil, keywords))
+
+	err := TimeValidator(ctx, nil)
+
+	if expectedErr == "" {
+		if err != nil {
+			t.Fatalf("Tested %v with %v. Didn't expect error, but got %s.", value, keywords, err)
+		}
+		return
+	}
+
+	if err == nil || err.Error() != expectedErr {
+		t.Fatalf("Tested %v with %v. Expected error '%s', but got '%v'.", value, keywords, expectedErr, err)
+	}
+}
+
+func TestThatTimeValidatorChecksAfterAndBeforeBounds(t *testing.T) {
+	layout := parser.Keywords{{Name: "layout", Value: "2006-01-02"}}
+	tomorrow := time.Now().Add(24 * time.Hour).Format("2006-01-02")
+
+	testThatTimeValidatorChecksBounds(t, tomorrow, append(layout, &parser.Keyword{Name: "after", Value: "now"}), "")
+	testThatTimeValidatorChecksBounds(t, "2013-06-05", append(layout, &parser.Keyword{Name: "after", Value: "now"}), "time.mustBeAfter")
+	testThatTimeValidatorChecksBounds(t, "2013-06-05", append(layout, &parser.Keyword{Name: "before", Value: "2013-06-06"}), "")
+	testThatTimeValidatorChecksBounds(t, "2013-06-06", append(layout, &parser.Keyword{Name: "before", Value: "2013-06-06"}), "time.mustBeBefore")
+	testThatTimeValidatorChecksBounds(t, "2013-06-05", append(layout, &parser.Keyword{Name: "after", Value: "2013-06-04"}, &parser.Keyword{Name: "before", Value: "2013-06-06"}), "")
+	testThatTimeValidatorChecksBounds(t, time.Now(), parser.Keywords{{Name: "before", Value: "now"}}, "")
+	testThatTimeValidatorChecksBounds(t, time.Date(2013, 6, 5, 0, 0, 0, 0, time.UTC), parser.Keywords{{Name: "after", Value: "2013-06-05T00:00:00Z"}}, "time.mustBeAfter")
+}
+
+func TestThatTimeValidatorFailsForInvalidBounds(t *testing.T) {
+	testThatTimeValidatorChecksBounds(t, "2013-06-05", parser.Keywords{{Name: "layout", Value: "2006-01-02"}, {Name: "after", Value: "yesterday"}}, "arguments.invalid")
+	testThatTimeValidatorChecksBounds(t, "2013-06-05", parser.Keywords{{Name: "layout", Value: "2006-01-02"}, {Name: "before", Value: int64(5)}}, "arguments.invalidType")
+	testThatTimeValidatorChecksBounds(t, "2013-06-05", parser.Keywords{{Name: "after", Value: "now"}}, "arguments.singleRequired")
+}
@@ -7,29 +7,72 @@ import (
 func RegisterDefaultLocale(lc *core.Locale) {
 	lc.Set("type.unsupported", "Validator '{validator}' does not support the type of field '{field}'.")
 	lc.Set("arguments.invalid", "Unable to parse '{validator}' validator options for field '{field}'.")
-	lc.Set("arguments.invalidType", "Validator '{validator}' on field '{field}' requires parameter %v to be of type %s.")
+	lc.Set("arguments.invalidType", "Validator '{validator}' on field '{field}' requires parameter {position} to be of type {type}.")
 	lc.Set("arguments.noneSupported", "Validator '{validator}' on field '{field}' does not support any arguments.")
 	lc.Set("arguments.singleRequired", "Validator '{validator}' on field '{field}' requires a single argument.")
 	lc.Set("arguments.oneOrMoreRequired", "Validator '{validator}' on field '{field}' requires at least one argument.")
-	lc.Set("not.cannotBeValue", "{field} cannot be %v.")
+	lc.Set("not.cannotBeValue", "{field} cannot be {forbidden}.")
 	lc.Set("nil.isNotNil", "{field} is not nil.")
 	lc.Set("empty.isNotEmpty", "{field} is not empty.")
 	lc.Set("notEmpty.cannotBeEmpty", "{field} cannot be empty.")
-	lc.Set("min.cannotBeShorterThan", "{field} cannot be shorter than %v characters.")
-	lc.Set("min.cannotBeLessThan", "{field} cannot be less than %v.")
-	lc.Set("min.cannotContainLessItemsThan", "{field} cannot contain less than %v items.")
-	lc.Set("min.cannotContainLessKeysThan", "{field} cannot contain less than %v keys.")
-	lc.Set("max.cannotBeLongerThan", "{field} cannot be longer than %v characters.")
-	lc.Set("max.cannotBeGreaterThan", "{field} cannot be greater than %v.")
-	lc.Set("max.cannotContainMoreItemsThan", "{field} cannot contain more than %v items.")
-	lc.Set("max.cannotContainMoreKeysThan", "{field} cannot contain more than %v keys.")
+	lc.Set("min.cannotBeShorterThan", "{field} cannot be shorter than {min, plural, one {# character} other {# characters}}.")
+	lc.Set("min.cannotBeLessThan", "{field} cannot be less than {min}.")
+	lc.Set("min.cannotContainLessItemsThan", "{field} cannot contain less than {min, plural, one {# item} other {# items}}.")
+	lc.Set("min.cannotContainLessKeysThan", "{field} cannot contain less than {min, plural, one {# key} other {# keys}}.")
+	lc.Set("max.cannotBeLongerThan", "{field} cannot be longer than {max, plural, one {# character} other {# characters}}.")
+	lc.Set("max.cannotBeGreaterThan", "{field} cannot be greater than {max}.")
+	lc.Set("max.cannotContainMoreItemsThan", "{field} cannot contain more than {max, plural, one {# item} other {# items}}.")
+	lc.Set("max.cannotContainMoreKeysThan", "{field} cannot contain more than {max, plural, one {# key} other {# keys}}.")
+	lc.Set("len.cannotBeShorterThan", "{field} cannot be shorter than {min, plural, one {# character} other {# characters}}.")
+	lc.Set("len.cannotBeLongerThan", "{field} cannot be longer than {max, plural, one {# character} other {# characters}}.")
+	lc.Set("len.cannotContainLessItemsThan", "{field} cannot contain less than {min, plural, one {# item} other {# items}}.")
+	lc.Set("len.cannotContainMoreItemsThan", "{field} cannot contain more than {max, plural, one {# item} other {# items}}.")
+	lc.Set("len.cannotContainLessKeysThan", "{field} cannot contain less than {min, plural, one {# key} other {# keys}}.")
+	lc.Set("len.cannotContainMoreKeysThan", "{field} cannot contain more than {max, plural, one {# key} other {# keys}}.")
 	lc.Set("lowerCase.mustBeLowerCase", "{field} must be in lower case.")
 	lc.Set("upperCase.mustBeUpperCase", "{field} must be in upper case.")
-	lc.Set("contain.mustContainValue", "{field} must contain one of the following values '%s'.")
-	lc.Set("equal.mustEqualValue", "{field} must equal one of the following values '%s'.")
-	lc.Set("regexp.mustMatchPattern", "{field} must match pattern '%s'.")
+	lc.Set("contain.mustContainValue", "{field} must contain one of the following values '{values}'.")
+	lc.Set("equal.mustEqualValue", "{field} must equal one of the following values '{values}'.")
+	lc.Set("regexp.mustMatchPattern", "{field} must match pattern '{pattern}'.")
 	lc.Set("numeric.mustBeNumeric", "{field} must be numeric.")
 	lc.Set("time.mustBeValid", "{field} must be a valid time.")
+	lc.Set("time.mustBeAfter", "{field} must be after {after}.")
+	lc.Set("time.mustBeBefore", "{field} must be before {before}.")
+	lc.Set("negation.mustNotSatisfy", "{field} must not satisfy {expression}.")
+	lc.Set("contain.negated", "{field} cannot contain '{0}'.")
+	lc.Set("equal.negated", "{field} cannot equal '{0}'.")
+	lc.Set("regexp.negated", "{field} cannot match pattern '{0}'.")
+	lc.Set("empty.negated", "{field} cannot be empty.")
+	lc.Set("nil.negated", "{field} cannot be nil.")
+	lc.Set("type.cannotValidateDirectly", "Unable to directly validate type '{type}'.")
+	lc.Set("validator.notRegistered", "Validator '{name}' is not registered.")
+	lc.Set("validator.panicked", "Validator '{validator}' on field '{field}' panicked: {panic}")
+	lc.Set("func.methodDoesNotExist", "Validation method '{method}' on field '{field}' does not exist.")
+	lc.Set("func.invalidParameters", "Validation method '{method}' must accept a core.ValidatorContext and a slice of arguments.")
+	lc.Set("func.callFailed", "Unable to call validation method '{method}'.")
+	lc.Set("func.invalidReturnValue", "Invalid return value(s) of validation method '{method}'. Return value must be of type 'error'.")
+	lc.Set("regexp.invalidPattern", "Unexpected regexp error for validator field '{field}': {error}")
+	lc.Set("reference.cannotResolve", "Reference '{reference}' can't be resolved.")
+	lc.Set("alias.invalidDeclaration", "Alias declaration '{declaration}' must be a name, optionally followed by parameter names in parentheses.")
+	lc.Set("alias.argumentCount", "Alias '{name}' expects the arguments ({params}), but got {count}.")
+	lc.Set("alias.cycle", "Alias '{name}' refers to itself: {cycle}.")
+	lc.Set("alias.invalidKeyword", "Alias '{name}' can't bind the keyword argument '{keyword}' to any of its parameters ({params}).")
+	lc.Set("parser.unexpectedCharacter", "Unexpected character {character} at position {position}.")
+	lc.Set("parser.unexpectedEnd", "Unexpected end at position {position}.")
+	lc.Set("parser.invalidNumber", "Argument '{argument}' at position {position} is not a valid number.")
+	lc.Set("parser.invalidBoolean", "Argument '{argument}' at position {position} is not a valid boolean.")
+	lc.Set("parser.invalidString", "Invalid string {string} at position {position}.")
+	lc.Set("parser.positionalAfterKeyword", "Positional argument at position {position} must come before the keyword arguments.")
+	lc.Set("parser.duplicateKeyword", "Keyword argument '{keyword}' at position {position} is given more than once.")
+	lc.Set("parser.unhandledToken", "Unable to parse. Unhandled token type.")
+	lc.Set("signature.expectsNone", "Validator '{name}' expects no arguments, but got {count}.")
+	lc.Set("signature.expectsExactly", "Validator '{name}' expects {required, plural, one {# argument} other {# arguments}}, but got {count}.")
+	lc.Set("signature.expectsAtLeast", "Validator '{name}' expects at least {required, plural, one {# argument} other {# arguments}}, but got {count}.")
+	lc.Set("signature.expectsBetween", "Validator '{name}' expects between {required} and {max, plural, one {# argument} other {# arguments}}, but got {count}.")
+	lc.Set("signature.invalidArgumentType", "Validator '{name}' requires argument {position} to be of type {type}.")
+	lc.Set("signature.unknownKeyword", "Validator '{name}' has no keyword argument '{keyword}'.")
+	lc.Set("signature.invalidKeywordType", "Validator '{name}' requires keyword argument '{keyword}' to be of type {type}.")
+	lc.Set("syntax.unknownMessageScope", "Message '{tag}.{scope}' doesn't match a method or method group of the field.")
 }
 
 func RegisterDefaultValidators(r core.ValidatorRegistry) {
@@ -39,6 +82,7 @@ func RegisterDefaultValidators(r core.ValidatorRegistry) {
 	r.Register("not_empty", NotEmptyValidator)
 	r.Register("min", MinValidator)
 	r.Register("max", MaxValidator)
+	r.Register("len", LenValidator)
 	r.Register("lowercase", LowerCaseValidator)
 	r.Register("uppercase", UpperCaseValidator)
 	r.Register("contain", ContainValidator)
@@ -48,3 +92,32 @@ func RegisterDefaultValidators(r core.ValidatorRegistry) {
 	r.Register("time", TimeValidator)
 	r.Register("func", FuncValidator)
 }
+
+func RegisterDefaultSignatures(r core.SignatureRegistry) {
+	r.Register("not", core.NewSignature(core.AnyArg).WithLocaleKeys("arguments.singleRequired", "not.cannotBeValue", "type.unsupported"))
+	r.Register("nil", core.NewSignature().WithLocaleKeys("arguments.noneSupported", "nil.isNotNil", "nil.negated"))
+	r.Register("empty", core.NewSignature().WithLocaleKeys("arguments.noneSupported", "empty.isNotEmpty", "empty.negated"))
+	r.Register("not_empty", core.NewSignature().WithLocaleKeys("arguments.noneSupported", "notEmpty.cannotBeEmpty"))
+	r.Register("min", core.NewSignature(core.NumberArg).WithLocaleKeys("arguments.singleRequired", "arguments.invalidType", "min.cannotBeShorterThan", "min.cannotBeLessThan", "min.cannotContainLessItemsThan", "min.cannotContainLessKeysThan", "type.unsupported"))
+	r.Register("max", core.NewSignature(core.NumberArg).WithLocaleKeys("arguments.singleRequired", "arguments.invalidType", "max.cannotBeLongerThan", "max.cannotBeGreaterThan", "max.cannotContainMoreItemsThan", "max.cannotContainMoreKeysThan", "type.unsupported"))
+	r.Register("len", (&core.Signature{Args: []core.ArgKind{core.NumberArg, core.NumberArg}, Required: 0, Keywords: map[string]core.ArgKind{"min": core.NumberArg, "max": core.NumberArg}}).WithLocaleKeys("arguments.oneOrMoreRequired", "arguments.invalid", "arguments.invalidType", "len.cannotBeShorterThan", "len.cannotBeLongerThan", "len.cannotContainLessItemsThan", "len.cannotContainMoreItemsThan", "len.cannotContainLessKeysThan", "len.cannotContainMoreKeysThan", "type.unsupported"))
+	r.Register("lowercase", core.NewSignature().WithLocaleKeys("arguments.noneSupported", "lowerCase.mustBeLowerCase", "type.unsupported"))
+	r.Register("uppercase", core.NewSignature().WithLocaleKeys("arguments.noneSupported", "upperCase.mustBeUpperCase", "type.unsupported"))
+	r.Register("contain", core.NewSignature(core.StringArg|core.ListArg).WithLocaleKeys("arguments.singleRequired", "arguments.invalid", "arguments.invalidType", "contain.mustContainValue", "contain.negated", "type.unsupported"))
+	r.Register("equal", core.NewSignature(core.AnyArg).WithLocaleKeys("arguments.singleRequired", "equal.mustEqualValue", "equal.negated", "type.unsupported"))
+	r.Register("regexp", core.NewSignature(core.StringArg).WithLocaleKeys("arguments.singleRequired", "arguments.invalidType", "regexp.mustMatchPattern", "regexp.negated", "regexp.invalidPattern", "type.unsupported"))
+	r.Register("numeric", core.NewSignature().WithLocaleKeys("arguments.noneSupported", "numeric.mustBeNumeric", "type.unsupported"))
+	r.Register("time", (&core.Signature{Args: []core.ArgKind{core.StringArg}, Required: 0, Keywords: map[string]core.ArgKind{"layout": core.StringArg, "after": core.StringArg, "before": core.StringArg}}).WithLocaleKeys("arguments.singleRequired", "arguments.invalid", "arguments.invalidType", "time.mustBeValid", "time.mustBeAfter", "time.mustBeBefore", "type.unsupported"))
+	r.Register("func", (&core.Signature{Args: []core.ArgKind{core.StringArg, core.AnyArg}, Required: 0, Variadic: true}).WithLocaleKeys("arguments.invalidType", "func.methodDoesNotExist", "func.invalidParameters", "func.callFailed", "func.invalidReturnValue"))
+}
+
+// RegisterDefaultLocales registers the built-in messages of all of the shipped languages in the bundle.
+func RegisterDefaultLocales(bundle *core.LocaleBundle) {
+	RegisterDefaultLocale(bundle.EnsureLocale("en"))
+	registerGermanLocale(bundle.EnsureLocale("de"))
+	registerSpanishLocale(bundle.EnsureLocale("es"))
+	registerFrenchLocale(bundle.EnsureLocale("fr"))
+	registerItalianLocale(bundle.EnsureLocale("it"))
+	registerDutchLocale(bundle.EnsureLocale("nl"))
+	registerPortugueseLocale(bundle.EnsureLocale("pt"))
+}
@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"strconv"
+	"strings"
+)
+
+// argumentValues returns the values of a list argument, or the argument itself for any other argument.
+func argumentValues(arg interface{}) []interface{} {
+	if list, ok := arg.(parser.List); ok {
+		return list
+	}
+	return []interface{}{arg}
+}
+
+// validatorArguments returns the positional arguments that a validator is called with, along with the keyword
+// arguments of the method, so that either can be looked up with Arguments.Get.
+func validatorArguments(context core.ValidatorContext, args []interface{}) *core.Arguments {
+	return core.NewArguments(args, context.Arguments().Keywords)
+}
+
+// formatValues formats values for a message, i.e. "a, b, c".
+func formatValues(values []interface{}) string {
+	formatted := make([]string, len(values))
+
+	for i, value := range values {
+		formatted[i] = fmt.Sprintf("%v", value)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// compareNumber compares a numeric value to a numeric argument of any type, see core.CompareNumbers. Values that can't
+// be compared, like NaN, are treated as equal to the argument.
+func compareNumber(value interface{}, argument interface{}) int {
+	comparison, _ := core.CompareNumbers(value, argument)
+	return comparison
+}
+
+// formatNumber formats a numeric argument as it's compared to strings, i.e. "5" or "1.5".
+func formatNumber(argument interface{}) string {
+	if typedArgument, ok := argument.(float64); ok {
+		return strconv.FormatFloat(typedArgument, 'f', -1, 64)
+	}
+	return fmt.Sprint(argument)
+}
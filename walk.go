@@ -1,9 +1,10 @@
 package validator
 
 import (
-	"errors"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	"reflect"
+	"runtime/debug"
 )
 
 func canWalk(value reflect.Kind) bool {
@@ -15,83 +16,233 @@ func canWalk(value reflect.Kind) bool {
 	}
 }
 
+// callValidator calls the validator method and converts any panic inside of it into a *core.PanicError.
+func callValidator(context *context, validate core.ValidatorFn, method *parser.Method) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = core.NewPanicError(recovered, debug.Stack())
+			context.validator.locales.Translate(err, context.options.language)
+
+			if context.validator.repanic {
+				panic(core.NewError(context.field, method, err))
+			}
+		}
+	}()
+
+	context.arguments = core.NewArguments(method.Arguments, method.Keywords)
+
+	return validate(context, method.Arguments)
+}
+
 func walkValidateArray(context *context, normalized *core.NormalizedValue, parentField *core.ReflectedField) {
 	valueType := reflect.ValueOf(normalized.Value)
 	for i := 0; i < valueType.Len(); i++ {
 		value := valueType.Index(i)
 		if canWalk(value.Kind()) {
-			walkValidate(context, value.Interface(), parentField)
+			walkValidate(context, value.Interface(), elementField(parentField, i))
 		}
 	}
 }
 
 func walkValidateMap(context *context, normalized *core.NormalizedValue, parentField *core.ReflectedField) {
 	valueType := reflect.ValueOf(normalized.Value)
-	for _, key := range valueType.MapKeys() {
+	for i, key := range core.SortedMapKeys(valueType, context.validator.mapKeyLess) {
 		value := valueType.MapIndex(key)
 		if canWalk(value.Kind()) {
-			walkValidate(context, value.Interface(), parentField)
+			walkValidate(context, value.Interface(), elementField(parentField, i))
 		}
 	}
 }
 
-func walkValidateStruct(context *context, normalized *core.NormalizedValue, parentField *core.ReflectedField) {
-	fields, err := core.GetStructFields(normalized.Value, "validate", context.validator.displayNameTag)
+// elementField copies the field whose value is walked element by element, with the index of the element added to its
+// elements, so that errors of the element can be ordered by it.
+func elementField(parentField *core.ReflectedField, index int) *core.ReflectedField {
+	if parentField == nil {
+		return nil
+	}
 
-	if err != nil {
-		context.errors.AddPlain(err)
-		return
+	field := *parentField
+	field.Elements = append(append([]int{}, parentField.Elements...), index)
+
+	return &field
+}
+
+// addConfigError handles a mistake in the validation setup according to the config error policy of the validator.
+func addConfigError(context *context, err *core.Error) {
+	if context.validator.configPolicy == PanicOnConfigErrors {
+		panic(err)
 	}
+	context.errors.Add(err)
+}
 
-	sourceStruct := reflect.Indirect(reflect.ValueOf(normalized.Value))
+// negationMethod is the method that errors of a negated, parenthesized expression are reported for.
+var negationMethod = &parser.Method{Name: "!"}
 
-	for _, field := range fields {
-		fieldValue := field.GetValue(sourceStruct)
+// evaluate evaluates an expression of the tag of a field. It returns the errors of the methods that failed and whether
+// or not a method is misconfigured. Configuration mistakes are reported right away, even if another alternative passes.
+// The branch is the index of the alternative that was taken at every '|' on the way down to the expression.
+func evaluate(context *context, field *core.ReflectedField, expression parser.Expression, branch []int) (core.ErrorList, bool) {
+	switch typedExpression := expression.(type) {
+	case *parser.Method:
+		return evaluateMethod(context, field, typedExpression, branch)
+	case parser.And:
+		var errors core.ErrorList
 
-		normalizedFieldValue, err := core.Normalize(fieldValue)
+		isMisconfigured := false
 
-		if err != nil {
-			context.errors.AddPlain(err)
-			continue
+		for _, operand := range typedExpression {
+			operandErrors, operandIsMisconfigured := evaluate(context, field, operand, branch)
+			errors.AddMany(operandErrors)
+			isMisconfigured = isMisconfigured || operandIsMisconfigured
 		}
 
-		field.Parent = parentField
+		return errors, isMisconfigured
+	case parser.Or:
+		// The first alternative that passes wins. If none does, the errors of the last alternative are reported.
+		var errors core.ErrorList
 
-		context.setField(field)
-		context.setSource(normalized.Value)
-		context.setValue(normalizedFieldValue)
+		isMisconfigured := false
 
-		var mostRecentErrors core.ErrorList
+		for i, operand := range typedExpression {
+			operandBranch := append(append([]int{}, branch...), i+1)
+			operandErrors, operandIsMisconfigured := evaluate(context, field, operand, operandBranch)
 
-		for _, methods := range field.MethodGroups {
-			var errors core.ErrorList
+			if !operandErrors.Any() && !operandIsMisconfigured {
+				return nil, false
+			}
 
-			for _, method := range methods {
-				validate, err := context.validator.registry.Get(method.Name)
+			errors = operandErrors
+			isMisconfigured = isMisconfigured || operandIsMisconfigured
+		}
 
-				if err != nil {
-					context.errors.AddPlain(err)
-					return
-				}
+		return errors, isMisconfigured
+	case *parser.Not:
+		errors, isMisconfigured := evaluate(context, field, typedExpression.Operand, branch)
 
-				if err = validate(context, method.Arguments); err != nil {
-					errors.Add(core.NewError(field, method, err))
-				}
-			}
+		if isMisconfigured {
+			return nil, true
+		}
+
+		if errors.Any() {
+			return nil, false
+		}
+
+		err := core.NewError(field, negationMethod, context.applyCustomMessage(groupIndexOf(field, branch), negationMethod.Name, context.negate(typedExpression.Operand, nil)))
+		err.SetBranch(branch)
 
-			mostRecentErrors = errors
+		return core.ErrorList{err}, false
+	default:
+		return nil, false
+	}
+}
+
+func evaluateMethod(context *context, field *core.ReflectedField, method *parser.Method, branch []int) (core.ErrorList, bool) {
+	validate, err := context.validator.registry.Get(method.Name)
+
+	if err == nil {
+		// Keyword arguments that the validator doesn't declare would be ignored by it, so they're config errors.
+		if keywordErr := context.validator.checkKeywords(method); keywordErr != nil {
+			err = core.NewConfigError(keywordErr)
+		}
+	}
+
+	if err == nil && method.HasReferences() {
+		// Validators and messages receive the values of the references, the cached method is left untouched.
+		resolvedMethod := *method
+		resolvedMethod.Arguments, err = context.resolveArguments(method.Arguments)
+
+		if err == nil {
+			resolvedMethod.Keywords, err = context.resolveKeywords(method.Keywords)
+		}
 
-			if !errors.Any() {
-				break
+		method = &resolvedMethod
+	}
+
+	if err == nil {
+		err = callValidator(context, validate, method)
+
+		if method.Negated {
+			err = context.negate(method, err)
+		}
+	}
+
+	if err == nil {
+		return nil, false
+	}
+
+	if core.IsConfigError(err) {
+		context.validator.locales.Translate(err, context.options.language)
+		configErr := core.NewError(field, method, err)
+		configErr.SetBranch(branch)
+		addConfigError(context, configErr)
+		return nil, true
+	}
+
+	fieldErr := core.NewError(field, method, context.applyCustomMessage(groupIndexOf(field, branch), method.Name, err))
+	fieldErr.SetBranch(branch)
+
+	return core.ErrorList{fieldErr}, false
+}
+
+// groupIndexOf returns the index of the method group, which is the top level alternative of the tag, that a branch
+// belongs to.
+func groupIndexOf(field *core.ReflectedField, branch []int) int {
+	if _, ok := field.Expression.(parser.Or); ok && len(branch) > 0 {
+		return branch[0] - 1
+	}
+	return 0
+}
+
+func walkValidateStruct(context *context, normalized *core.NormalizedValue, parentField *core.ReflectedField) {
+	sourceStruct := reflect.Indirect(reflect.ValueOf(normalized.Value))
+
+	if context.validator.configPolicy == CheckSyntaxBeforeValidate {
+		if errs := context.validator.checkedStruct(sourceStruct.Type()); len(errs) > 0 {
+			for _, err := range errs {
+				context.errors.AddPlain(context.validator.locales.Translated(err, context.options.language))
 			}
+			return
 		}
+	}
+
+	fields, err := context.validator.getStructFields(sourceStruct.Type())
 
-		if mostRecentErrors.Any() {
-			context.errors.AddMany(mostRecentErrors)
+	if err != nil {
+		err = context.validator.locales.Translated(err, context.options.language)
+		addConfigError(context, core.NewPlainError(locateConfigError(err, sourceStruct.Type(), "")))
+		return
+	}
+
+	for _, cachedField := range fields {
+		// Copy the cached field so that errors keep referencing the parent they were found under,
+		// even if the same struct type is walked again from another place.
+		field := *cachedField
+		field.Parent = parentField
+
+		// Localize the display name once per walk, so that errors of the field and of its children compose the
+		// localized names of their path.
+		displayName := field.LocalizedDisplayName(context.validator.locales, context.options.language)
+		field.DisplayName = &displayName
+
+		fieldValue := field.GetValue(sourceStruct)
+
+		normalizedFieldValue, err := core.Normalize(fieldValue)
+
+		if err != nil {
+			context.errors.AddPlain(err)
+			continue
+		}
+
+		context.setField(&field)
+		context.setSource(normalized.Value)
+		context.setValue(normalizedFieldValue)
+
+		if errors, _ := evaluate(context, &field, field.Expression, nil); errors.Any() {
+			context.errors.AddMany(errors)
 		}
 
 		if canWalk(normalizedFieldValue.OriginalKind) {
-			walkValidate(context, normalizedFieldValue, field)
+			walkValidate(context, normalizedFieldValue, &field)
 		}
 	}
 }
@@ -119,6 +270,8 @@ func walkValidate(context *context, value interface{}, parentField *core.Reflect
 			walkValidateStruct(context, normalized, parentField)
 		}
 	default:
-		context.errors.AddPlain(errors.New("Unable to directly validate type '" + normalized.OriginalKind.String() + "'."))
+		err := core.NewMessage("type.cannotValidateDirectly", "Unable to directly validate type '{type}'.", normalized.Value, core.Params{"type": normalized.OriginalKind.String()})
+		context.validator.locales.Translate(err, context.options.language)
+		context.errors.AddPlain(err)
 	}
 }
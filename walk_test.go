@@ -1,8 +1,11 @@
 package validator_test
 
 import (
+	"errors"
 	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -109,3 +112,256 @@ func TestThatValidatorCannotWalkBool(t *testing.T) {
 func TestThatValidatorCannotWalkInvalid(t *testing.T) {
 	testThatValidatorCannotWalkValue(t, nil, "invalid")
 }
+
+func TestThatValidatorWalksMapInDeterministicOrder(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"is_value"`
+	}
+
+	validator := New()
+
+	validator.Register("is_value", func(ctx core.ValidatorContext, args []interface{}) error {
+		return errors.New(ctx.Value().(string))
+	})
+
+	dummies := map[string]*Dummy{}
+
+	for _, key := range []string{"d", "b", "a", "e", "c"} {
+		dummies[key] = &Dummy{Value: key}
+	}
+
+	for i := 0; i < 10; i++ {
+		var values []string
+
+		for _, err := range validator.Validate(dummies) {
+			values = append(values, err.Error())
+		}
+
+		if strings.Join(values, ",") != "a,b,c,d,e" {
+			t.Fatalf("Expected errors in order 'a,b,c,d,e', but got '%s'.", strings.Join(values, ","))
+		}
+	}
+}
+
+func TestThatValidatorWalksMapWithComparatorForUnorderableKeys(t *testing.T) {
+	type Key struct {
+		Rank int
+	}
+
+	type Dummy struct {
+		Value string `validate:"is_value"`
+	}
+
+	validator := New()
+
+	validator.Register("is_value", func(ctx core.ValidatorContext, args []interface{}) error {
+		return errors.New(ctx.Value().(string))
+	})
+
+	validator.SetMapKeyComparator(func(a reflect.Value, b reflect.Value) bool {
+		return a.Interface().(Key).Rank > b.Interface().(Key).Rank
+	})
+
+	dummies := map[Key]*Dummy{{1}: {Value: "1"}, {3}: {Value: "3"}, {2}: {Value: "2"}}
+
+	var values []string
+
+	for _, err := range validator.Validate(dummies) {
+		values = append(values, err.Error())
+	}
+
+	if strings.Join(values, ",") != "3,2,1" {
+		t.Fatalf("Expected errors in order '3,2,1', but got '%s'.", strings.Join(values, ","))
+	}
+}
+
+type configErrorDummy struct {
+	First  string `validate:"unknown_validator"`
+	Second string `validate:"min(´abc´)"`
+	Third  string `validate:"not_empty"`
+}
+
+func TestThatValidatorReportsConfigErrorsPerFieldAndContinues(t *testing.T) {
+	errs := New().Validate(&configErrorDummy{})
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, but got %d.", errs.Length())
+	}
+
+	for i, fieldName := range []string{"First", "Second", "Third"} {
+		if errs[i].GetFieldName() != fieldName {
+			t.Fatalf("Expected error %d to be for field '%s', but got '%s'.", i, fieldName, errs[i].GetFieldName())
+		}
+
+		if isConfigError := i < 2; errs[i].IsConfigError() != isConfigError {
+			t.Fatalf("Expected error %d to be a config error: %v, but it wasn't.", i, isConfigError)
+		}
+	}
+
+	if errs.First().Error() != "Validator 'unknown_validator' is not registered." {
+		t.Fatalf("Expected unknown validator error, but got '%s'.", errs.First())
+	}
+}
+
+func TestThatValidatorReportsConfigErrorsEvenIfAnotherGroupPasses(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"unknown_validator|empty"`
+	}
+
+	errs := New().Validate(&Dummy{})
+
+	if errs.Length() != 1 || !errs.First().IsConfigError() {
+		t.Fatalf("Expected a single config error, but got %d errors.", errs.Length())
+	}
+}
+
+func TestThatValidatorPanicsOnConfigErrorsWhenEnabled(t *testing.T) {
+	validator := New()
+	validator.SetConfigErrorPolicy(PanicOnConfigErrors)
+
+	defer func() {
+		err, ok := recover().(*core.Error)
+
+		if !ok || !err.IsConfigError() || err.GetFieldName() != "First" {
+			t.Fatalf("Expected panic with config error for field 'First', but got '%v'.", err)
+		}
+	}()
+
+	validator.Validate(&configErrorDummy{})
+
+	t.Fatal("Expected panic, but didn't get any.")
+}
+
+func TestThatValidatorChecksSyntaxBeforeValidateWhenEnabled(t *testing.T) {
+	validator := New()
+	validator.SetConfigErrorPolicy(CheckSyntaxBeforeValidate)
+
+	errs := validator.Validate(&configErrorDummy{})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, but got %d.", errs.Length())
+	}
+
+	if !errs.First().IsConfigError() || errs.First().IsFieldError() {
+		t.Fatalf("Expected a plain config error, but got '%s'.", errs.First())
+	}
+
+	expectedErr := "validator_test.configErrorDummy.First: Validator 'unknown_validator' is not registered."
+
+	if errs.First().Error() != expectedErr {
+		t.Fatalf("Expected error '%s', but got '%s'.", expectedErr, errs.First())
+	}
+
+	// The checked errors are cached per struct type, but are translated for each call.
+	if err := validator.Validate(&configErrorDummy{}, WithLanguage("de")).First(); err == nil || err.Error() != "validator_test.configErrorDummy.First: Der Validator 'unknown_validator' ist nicht registriert." {
+		t.Fatalf("Expected German error, but got '%v'.", err)
+	}
+
+	if err := validator.Validate(&configErrorDummy{}).First(); err == nil || err.Error() != expectedErr {
+		t.Fatalf("Expected error '%s', but got '%v'.", expectedErr, err)
+	}
+
+	validator.Register("unknown_validator", func(ctx core.ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	if errs = validator.Validate(&configErrorDummy{}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error after registering the validator, but got %d.", errs.Length())
+	}
+}
+
+func TestThatSortedErrorsAreOrderedByElement(t *testing.T) {
+	type Item struct {
+		Name string `validate:"not_empty"`
+	}
+
+	type Dummy struct {
+		Items  []Item
+		Lookup map[string]Item
+	}
+
+	errs := New().Validate(&Dummy{
+		Items:  []Item{{}, {Name: "b"}, {}},
+		Lookup: map[string]Item{"z": {}, "a": {}},
+	})
+
+	if errs.Length() != 4 {
+		t.Fatalf("Expected 4 errors, but got %v.", errs)
+	}
+
+	reversed := make(core.ErrorList, len(errs))
+
+	for i, err := range errs {
+		reversed[len(errs)-1-i] = err
+	}
+
+	sorted := reversed.Sorted()
+
+	for i, err := range errs {
+		if sorted[i] != err {
+			t.Fatalf("Expected error %d to be %v, but got %v.", i, err, sorted[i])
+		}
+	}
+}
+
+func TestThatValidatorReportsUndeclaredKeywordArgumentsAsConfigErrors(t *testing.T) {
+	type Dummy struct {
+		Name  string `validate:"len(foo=3)"`
+		Empty string `validate:"empty(x=1)"`
+	}
+
+	errs := New().Validate(&Dummy{Name: "abcdef"})
+
+	expected := []string{
+		"Validator 'len' has no keyword argument 'foo'.",
+		"Validator 'empty' has no keyword argument 'x'.",
+	}
+
+	if errs.Length() != len(expected) {
+		t.Fatalf("Expected %d errors, but got %v.", len(expected), errs)
+	}
+
+	for i, err := range errs {
+		if !err.IsConfigError() || err.Error() != expected[i] {
+			t.Fatalf("Expected config error '%s', but got '%s'.", expected[i], err)
+		}
+	}
+
+	if err := New().Validate(&Dummy{}, WithLanguage("de")).First(); err == nil || err.GetLocaleKey() != "signature.unknownKeyword" || strings.Contains(err.Error(), "has no keyword") {
+		t.Fatalf("Expected German unknown keyword error, but got '%v'.", err)
+	}
+}
+
+func TestThatValidatorLocalizesConfigAndInternalErrors(t *testing.T) {
+	type BrokenDummy struct {
+		Value string `validate:"min(1"`
+	}
+
+	validator := New()
+
+	tests := []struct {
+		value       interface{}
+		expectedErr string
+		expectedKey string
+	}{
+		{&configErrorDummy{}, "Der Validator 'unknown_validator' ist nicht registriert.", "validator.notRegistered"},
+		{&BrokenDummy{}, "validator_test.BrokenDummy.Value: Unerwartetes Ende an Position 5.", "parser.unexpectedEnd"},
+		{123, "Der Typ 'int' kann nicht direkt validiert werden.", "type.cannotValidateDirectly"},
+	}
+
+	for _, test := range tests {
+		err := validator.Validate(test.value, WithLanguage("de")).First()
+
+		if err == nil || err.Error() != test.expectedErr || err.GetLocaleKey() != test.expectedKey {
+			t.Fatalf("Expected error '%s' with key '%s', but got '%v'.", test.expectedErr, test.expectedKey, err)
+		}
+	}
+
+	validator.SetLanguage("fr")
+
+	errs, _ := validator.CheckSyntax(&configErrorDummy{}).(core.ConfigErrors)
+
+	if expectedErr := "validator_test.configErrorDummy.Second: Le validateur 'min' exige que l'argument 1 soit de type number."; len(errs) != 2 || errs[1].Error() != expectedErr {
+		t.Fatalf("Expected error '%s', but got '%v'.", expectedErr, errs)
+	}
+}